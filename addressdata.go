@@ -9,30 +9,74 @@ import (
 
 // AddressData contains generated data for an address derived from a
 // hdwallet.
+//
+// PrivKeyEncoded is PrivKey in the chain's own encoding, such as WIF for bitcoin.
+// AddressHex is set for chains whose addresses are usually encoded
+// but which are also commonly used in hex, such as Tron.
+// StakeAddress and ExtendedPubKey are set for Cardano addresses.
+// WithdrawalPubKey and WithdrawalPrivKey are set for Ethereum validators.
+// AddressFormat is set for chains whose addresses have more than one encoding,
+// such as Bitcoin Cash's CashAddr and legacy formats.
+// For taproot addresses, PubKey is the x-only internal key and
+// TweakedPubKey is the x-only output key.
 type AddressData struct {
-	Address        string
-	Name           string
-	Entropy        string
-	PubKey         string
-	PrivKey        string
-	Mnemonic       string
-	Seed           string
-	WalletIndex    int
-	DerivationPath string
-	Hardened       bool
+	Address           string
+	AddressHex        string
+	StakeAddress      string
+	Name              string
+	Chain             string
+	Network           string
+	AddressType       string
+	AddressFormat     string
+	Entropy           string
+	PubKey            string
+	TweakedPubKey     string
+	ExtendedPubKey    string
+	PrivKey           string
+	PrivKeyEncoded    string
+	WithdrawalPubKey  string
+	WithdrawalPrivKey string
+	Mnemonic          string
+	Seed              string
+	WalletIndex       int
+	DerivationPath    string
+	PathPreset        string
+	Hardened          bool
 }
 
 func (a AddressData) BuildOutput() (ad AddressDataOutput) {
 	ad = AddressDataOutput{
-		Address:     utils.ToPointer(a.Address),
-		Hardened:    utils.ToPointer(a.Hardened),
-		WalletIndex: utils.ToPointer(a.WalletIndex),
+		Address: utils.ToPointer(a.Address),
+	}
+
+	if !checkZeroVal(a.AddressHex) {
+		ad.AddressHex = utils.ToPointer(a.AddressHex)
+	}
+
+	if !checkZeroVal(a.StakeAddress) {
+		ad.StakeAddress = utils.ToPointer(a.StakeAddress)
 	}
 
 	if !checkZeroVal(a.Name) {
 		ad.Name = utils.ToPointer(a.Name)
 	}
 
+	if !checkZeroVal(a.Chain) {
+		ad.Chain = utils.ToPointer(a.Chain)
+	}
+
+	if !checkZeroVal(a.Network) {
+		ad.Network = utils.ToPointer(a.Network)
+	}
+
+	if !checkZeroVal(a.AddressType) {
+		ad.AddressType = utils.ToPointer(a.AddressType)
+	}
+
+	if !checkZeroVal(a.AddressFormat) {
+		ad.AddressFormat = utils.ToPointer(a.AddressFormat)
+	}
+
 	if !checkZeroVal(a.Entropy) {
 		ad.Entropy = utils.ToPointer(a.Entropy)
 	}
@@ -41,10 +85,30 @@ func (a AddressData) BuildOutput() (ad AddressDataOutput) {
 		ad.Pubkey = utils.ToPointer(a.PubKey)
 	}
 
+	if !checkZeroVal(a.TweakedPubKey) {
+		ad.TweakedPubkey = utils.ToPointer(a.TweakedPubKey)
+	}
+
+	if !checkZeroVal(a.ExtendedPubKey) {
+		ad.ExtendedPubkey = utils.ToPointer(a.ExtendedPubKey)
+	}
+
 	if !checkZeroVal(a.PrivKey) {
 		ad.Privkey = utils.ToPointer(a.PrivKey)
 	}
 
+	if !checkZeroVal(a.PrivKeyEncoded) {
+		ad.PrivkeyEncoded = utils.ToPointer(a.PrivKeyEncoded)
+	}
+
+	if !checkZeroVal(a.WithdrawalPubKey) {
+		ad.WithdrawalPubkey = utils.ToPointer(a.WithdrawalPubKey)
+	}
+
+	if !checkZeroVal(a.WithdrawalPrivKey) {
+		ad.WithdrawalPrivkey = utils.ToPointer(a.WithdrawalPrivKey)
+	}
+
 	if !checkZeroVal(a.Mnemonic) {
 		ad.Mnemonic = strings.Split(a.Mnemonic, " ")
 	}
@@ -53,8 +117,15 @@ func (a AddressData) BuildOutput() (ad AddressDataOutput) {
 		ad.Seed = utils.ToPointer(a.Seed)
 	}
 
+	// chains whose keys aren't derived from paths have no wallet index
 	if !checkZeroVal(a.DerivationPath) {
 		ad.DerivationPath = utils.ToPointer(a.DerivationPath)
+		ad.WalletIndex = utils.ToPointer(a.WalletIndex)
+		ad.Hardened = utils.ToPointer(a.Hardened)
+	}
+
+	if !checkZeroVal(a.PathPreset) {
+		ad.PathPreset = utils.ToPointer(a.PathPreset)
 	}
 
 	return
@@ -69,24 +140,48 @@ func (a AddressData) FormatOutput(excludes map[string]bool) AddressDataOutput {
 
 	for _, field := range datakeys.FieldOrder {
 		switch field {
+		case datakeys.AddressHex:
+			checkExclude(excludes, field, &out.AddressHex)
+		case datakeys.StakeAddress:
+			checkExclude(excludes, field, &out.StakeAddress)
 		case datakeys.Name:
-			checkExclude(excludes, field, utils.ToPointer(out.Name))
+			checkExclude(excludes, field, &out.Name)
+		case datakeys.Chain:
+			checkExclude(excludes, field, &out.Chain)
+		case datakeys.Network:
+			checkExclude(excludes, field, &out.Network)
+		case datakeys.AddressType:
+			checkExclude(excludes, field, &out.AddressType)
+		case datakeys.AddressFormat:
+			checkExclude(excludes, field, &out.AddressFormat)
 		case datakeys.Entropy:
-			checkExclude(excludes, field, utils.ToPointer(out.Entropy))
+			checkExclude(excludes, field, &out.Entropy)
 		case datakeys.Mnemonic:
-			checkExclude(excludes, field, utils.ToPointer(out.Mnemonic))
+			checkExclude(excludes, field, &out.Mnemonic)
 		case datakeys.Seed:
-			checkExclude(excludes, field, utils.ToPointer(out.Seed))
+			checkExclude(excludes, field, &out.Seed)
 		case datakeys.Pubkey:
-			checkExclude(excludes, field, utils.ToPointer(out.Pubkey))
+			checkExclude(excludes, field, &out.Pubkey)
+		case datakeys.TweakedPubkey:
+			checkExclude(excludes, field, &out.TweakedPubkey)
+		case datakeys.ExtendedPubkey:
+			checkExclude(excludes, field, &out.ExtendedPubkey)
 		case datakeys.Privkey:
-			checkExclude(excludes, field, utils.ToPointer(out.Privkey))
+			checkExclude(excludes, field, &out.Privkey)
+		case datakeys.PrivkeyEncoded:
+			checkExclude(excludes, field, &out.PrivkeyEncoded)
+		case datakeys.WithdrawalPubkey:
+			checkExclude(excludes, field, &out.WithdrawalPubkey)
+		case datakeys.WithdrawalPrivkey:
+			checkExclude(excludes, field, &out.WithdrawalPrivkey)
 		case datakeys.WalletIndex:
-			checkExclude(excludes, field, utils.ToPointer(out.WalletIndex))
+			checkExclude(excludes, field, &out.WalletIndex)
 		case datakeys.DerivationPath:
-			checkExclude(excludes, field, utils.ToPointer(out.DerivationPath))
+			checkExclude(excludes, field, &out.DerivationPath)
+		case datakeys.PathPreset:
+			checkExclude(excludes, field, &out.PathPreset)
 		case datakeys.Hardened:
-			checkExclude(excludes, field, utils.ToPointer(out.Hardened))
+			checkExclude(excludes, field, &out.Hardened)
 		}
 	}
 
@@ -0,0 +1,30 @@
+package bip39gen
+
+import (
+	"testing"
+
+	"github.com/jalavosus/bip39gen/internal/datakeys"
+)
+
+func TestFormatOutputExcludes(t *testing.T) {
+	addr, err := NewGenerator(
+		WithMnemonic("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"),
+	).GenerateAddress()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out := addr.FormatOutput(map[string]bool{
+		datakeys.Mnemonic:    true,
+		datakeys.Privkey:     true,
+		datakeys.WalletIndex: true,
+	})
+
+	if out.Mnemonic != nil || out.Privkey != nil || out.WalletIndex != nil {
+		t.Errorf("excluded fields were output: %+v", out)
+	}
+
+	if out.Address == nil || *out.Address != addr.Address || out.Pubkey == nil || out.Seed == nil {
+		t.Errorf("fields which weren't excluded are missing: %+v", out)
+	}
+}
@@ -10,16 +10,28 @@ import (
 // AddressDataOutput is only used for data output,
 // and is almost always created by AddressData.BuildOutput.
 type AddressDataOutput struct {
-	Address        *string  `json:"address" yaml:"address" toml:"address"`
-	Name           *string  `json:"name,omitempty" yaml:"name,omitempty" toml:"name,omitempty"`
-	Pubkey         *string  `json:"pubkey,omitempty" yaml:"pubkey,omitempty" toml:"pubkey,omitempty"`
-	Privkey        *string  `json:"privkey,omitempty" yaml:"privkey,omitempty" toml:"privkey,omitempty"`
-	Mnemonic       []string `json:"mnemonic,omitempty" yaml:"mnemonic,omitempty" toml:"mnemonic,omitempty"`
-	Seed           *string  `json:"seed,omitempty" yaml:"seed,omitempty" toml:"seed,omitempty"`
-	Entropy        *string  `json:"entropy,omitempty" yaml:"entropy,omitempty" toml:"entropy,omitempty"`
-	WalletIndex    *int     `json:"wallet_index,omitempty" yaml:"wallet_index,omitempty" toml:"wallet_index,omitempty"`
-	DerivationPath *string  `json:"derivation_path,omitempty" yaml:"derivation_path,omitempty" toml:"derivation_path,omitempty"`
-	Hardened       *bool    `json:"hardened,omitempty" yaml:"hardened,omitempty" toml:"hardened,omitempty"`
+	Address           *string  `json:"address" yaml:"address" toml:"address"`
+	AddressHex        *string  `json:"address_hex,omitempty" yaml:"address_hex,omitempty" toml:"address_hex,omitempty"`
+	StakeAddress      *string  `json:"stake_address,omitempty" yaml:"stake_address,omitempty" toml:"stake_address,omitempty"`
+	Name              *string  `json:"name,omitempty" yaml:"name,omitempty" toml:"name,omitempty"`
+	Chain             *string  `json:"chain,omitempty" yaml:"chain,omitempty" toml:"chain,omitempty"`
+	Network           *string  `json:"network,omitempty" yaml:"network,omitempty" toml:"network,omitempty"`
+	AddressType       *string  `json:"address_type,omitempty" yaml:"address_type,omitempty" toml:"address_type,omitempty"`
+	AddressFormat     *string  `json:"address_format,omitempty" yaml:"address_format,omitempty" toml:"address_format,omitempty"`
+	Pubkey            *string  `json:"pubkey,omitempty" yaml:"pubkey,omitempty" toml:"pubkey,omitempty"`
+	TweakedPubkey     *string  `json:"tweaked_pubkey,omitempty" yaml:"tweaked_pubkey,omitempty" toml:"tweaked_pubkey,omitempty"`
+	ExtendedPubkey    *string  `json:"extended_pubkey,omitempty" yaml:"extended_pubkey,omitempty" toml:"extended_pubkey,omitempty"`
+	Privkey           *string  `json:"privkey,omitempty" yaml:"privkey,omitempty" toml:"privkey,omitempty"`
+	PrivkeyEncoded    *string  `json:"privkey_encoded,omitempty" yaml:"privkey_encoded,omitempty" toml:"privkey_encoded,omitempty"`
+	WithdrawalPubkey  *string  `json:"withdrawal_pubkey,omitempty" yaml:"withdrawal_pubkey,omitempty" toml:"withdrawal_pubkey,omitempty"`
+	WithdrawalPrivkey *string  `json:"withdrawal_privkey,omitempty" yaml:"withdrawal_privkey,omitempty" toml:"withdrawal_privkey,omitempty"`
+	Mnemonic          []string `json:"mnemonic,omitempty" yaml:"mnemonic,omitempty" toml:"mnemonic,omitempty"`
+	Seed              *string  `json:"seed,omitempty" yaml:"seed,omitempty" toml:"seed,omitempty"`
+	Entropy           *string  `json:"entropy,omitempty" yaml:"entropy,omitempty" toml:"entropy,omitempty"`
+	WalletIndex       *int     `json:"wallet_index,omitempty" yaml:"wallet_index,omitempty" toml:"wallet_index,omitempty"`
+	DerivationPath    *string  `json:"derivation_path,omitempty" yaml:"derivation_path,omitempty" toml:"derivation_path,omitempty"`
+	PathPreset        *string  `json:"path_preset,omitempty" yaml:"path_preset,omitempty" toml:"path_preset,omitempty"`
+	Hardened          *bool    `json:"hardened,omitempty" yaml:"hardened,omitempty" toml:"hardened,omitempty"`
 }
 
 func (a AddressDataOutput) mnemonic() *string {
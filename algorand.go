@@ -0,0 +1,127 @@
+package bip39gen
+
+import (
+	"crypto/ed25519"
+	"crypto/sha512"
+	"encoding/base32"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/tyler-smith/go-bip39"
+)
+
+// Algorand is the Chain for Algorand, whose keys aren't derived from paths:
+// the 32-byte BIP39 entropy of a 24-word mnemonic is used as the ed25519 seed,
+// so a mnemonic only has a single account.
+//
+// Addresses are the base32 encoded public key followed by a checksum,
+// and PrivKeyEncoded is the seed's 25-word Algorand mnemonic.
+var Algorand Chain = algorandChain{}
+
+const (
+	algorandSeedSize     = ed25519.SeedSize
+	algorandChecksumSize = 4
+)
+
+type algorandChain struct {
+	entropyEngine
+}
+
+func (algorandChain) Name() string {
+	return "algorand"
+}
+
+// DefaultPathTemplate returns the zero PathTemplate,
+// as Algorand keys aren't derived from paths.
+func (algorandChain) DefaultPathTemplate() PathTemplate {
+	return PathTemplate{}
+}
+
+func (algorandChain) fillAddressData(key hdKey, ad *AddressData) error {
+	seed := key.(entropyKey)
+
+	words, err := AlgorandMnemonicFromEntropy(seed)
+	if err != nil {
+		return err
+	}
+
+	var (
+		privKey = ed25519.NewKeyFromSeed(seed)
+		pubKey  = privKey.Public().(ed25519.PublicKey)
+	)
+
+	ad.Address = algorandAddress(pubKey)
+	ad.PubKey = common.Bytes2Hex(pubKey)
+	ad.PrivKey = common.Bytes2Hex(privKey.Seed())
+	ad.PrivKeyEncoded = strings.Join(words, " ")
+
+	return nil
+}
+
+// AlgorandMnemonicFromEntropy returns the 25-word Algorand mnemonic
+// of the ed25519 seed entropy, such as the 32 bytes of entropy
+// returned by GenerateMnemonicAndEntropy for a 24-word mnemonic.
+//
+// Algorand mnemonics encode the seed as 11-bit little-endian words
+// from the BIP39 wordlist, followed by a checksum word.
+func AlgorandMnemonicFromEntropy(entropy []byte) ([]string, error) {
+	if len(entropy) != algorandSeedSize {
+		return nil, wrapErr(ErrDerivation, errors.Errorf(
+			"algorand keys require %d bytes of entropy, i.e. a 24-word mnemonic, got %d bytes",
+			algorandSeedSize, len(entropy),
+		))
+	}
+
+	var (
+		wordList = bip39.GetWordList()
+		checksum = sha512.Sum512_256(entropy)
+		indices  = toUint11s(entropy)
+		words    = make([]string, 0, len(indices)+1)
+	)
+
+	for _, i := range indices {
+		words = append(words, wordList[i])
+	}
+
+	words = append(words, wordList[toUint11s(checksum[:2])[0]])
+
+	return words, nil
+}
+
+// toUint11s splits data into 11-bit integers, least significant bits first,
+// padding the last integer with zeros.
+func toUint11s(data []byte) []uint16 {
+	var (
+		buf    uint32
+		bits   uint
+		output = make([]uint16, 0, (len(data)*8+10)/11)
+	)
+
+	for _, b := range data {
+		buf |= uint32(b) << bits
+		bits += 8
+
+		if bits >= 11 {
+			output = append(output, uint16(buf&0x7ff))
+			buf >>= 11
+			bits -= 11
+		}
+	}
+
+	if bits > 0 {
+		output = append(output, uint16(buf&0x7ff))
+	}
+
+	return output
+}
+
+// algorandAddress returns the address of pubKey, which is checksummed
+// using the last bytes of its SHA-512/256 hash.
+func algorandAddress(pubKey []byte) string {
+	checksum := sha512.Sum512_256(pubKey)
+
+	return base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(
+		append(append([]byte{}, pubKey...), checksum[len(checksum)-algorandChecksumSize:]...),
+	)
+}
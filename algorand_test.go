@@ -0,0 +1,59 @@
+package bip39gen
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestAlgorandMnemonicFromEntropy(t *testing.T) {
+	words, err := AlgorandMnemonicFromEntropy(make([]byte, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := strings.Repeat("abandon ", 24) + "invest"; strings.Join(words, " ") != want {
+		t.Errorf("got %s, want %s", strings.Join(words, " "), want)
+	}
+
+	if _, err = AlgorandMnemonicFromEntropy(make([]byte, 16)); !errors.Is(err, ErrDerivation) {
+		t.Errorf("got error %v, want %v", err, ErrDerivation)
+	}
+}
+
+func TestAlgorandAddress(t *testing.T) {
+	// the address of the zero public key
+	if got, want := algorandAddress(make([]byte, 32)), "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAY5HFKQ"; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestAlgorandOutput(t *testing.T) {
+	addr, err := NewGenerator(WithChain(Algorand), WithMnemonicLength(24)).GenerateAddress()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := json.Marshal(addr.BuildOutput())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, field := range []string{"wallet_index", "hardened", "derivation_path"} {
+		if strings.Contains(string(out), field) {
+			t.Errorf("output contains %s: %s", field, out)
+		}
+	}
+}
+
+func TestAlgorandPathTemplate(t *testing.T) {
+	_, err := NewGenerator(
+		WithChain(Algorand),
+		WithMnemonicLength(24),
+		WithPathTemplate(DefaultPathTemplate),
+	).GenerateAddresses(1)
+	if !errors.Is(err, ErrInvalidPathTemplate) {
+		t.Errorf("got error %v, want %v", err, ErrInvalidPathTemplate)
+	}
+}
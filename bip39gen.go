@@ -1,181 +1,116 @@
 package bip39gen
 
 import (
-	"math/rand"
+	"crypto/rand"
+	"io"
 	"strings"
-	"time"
 
-	"github.com/btcsuite/btcd/btcutil/hdkeychain"
-	"github.com/ethereum/go-ethereum/common"
 	"github.com/jalavosus/hdwallet-go"
+	"github.com/pkg/errors"
 	"github.com/tyler-smith/go-bip39"
-
-	"github.com/jalavosus/bip39gen/internal/types"
-)
-
-var (
-	rando *rand.Rand
 )
 
-func init() {
-	seed := time.Now().UnixNano()
-
-	// call this so external libraries utilizing
-	// math/rand have a decent seed source.
-	rand.Seed(seed)
-
-	src := rand.NewSource(seed)
-	rando = rand.New(src)
-}
-
-// GenerateAddressesSingleMnemonic generates a slice of AddressData structs, all of which
-// are initialized using the same entropy, seed, and mnemonic.
-func GenerateAddressesSingleMnemonic(params *types.GeneratorParams, num int) (addrs []AddressData) {
-	addrs = make([]AddressData, num)
-
-	for i := 0; i < num; i++ {
-		var idx = i
-
-		if !params.SequentialIndex {
-			randInt := rando.Intn(1000) + 1
-
-			if params.Hardened {
-				randInt += hdkeychain.HardenedKeyStart
-			}
-
-			idx = randInt
-		}
-
-		addrs[i] = generateAddress(params, idx)
+// GenerateMnemonic returns a randomly generated BIP39 mnemonic
+// of the given length, split into its words.
+func GenerateMnemonic(length int) ([]string, error) {
+	mnemonic, _, err := GenerateMnemonicAndEntropy(length)
+	if err != nil {
+		return nil, err
 	}
 
-	return
+	return strings.Split(mnemonic, " "), nil
 }
 
-// GenerateAddress returns a AddressData struct initialized
-// using random values for entropy, seed, mnemonic, and wallet index.
-func GenerateAddress(params *types.GeneratorParams) AddressData {
-	randInt := rando.Intn(1000) + 1
-	if params.Hardened {
-		randInt += hdkeychain.HardenedKeyStart
-	}
-
-	return generateAddress(params, randInt)
+// GenerateMnemonicAndEntropy returns a randomly generated BIP39 mnemonic
+// of the given length, as well as the entropy it was generated from.
+// Entropy is read from crypto/rand; use GenerateMnemonicAndEntropyFrom
+// to provide a different randomness source.
+func GenerateMnemonicAndEntropy(length int) (mnemonic string, entropy []byte, err error) {
+	return GenerateMnemonicAndEntropyFrom(rand.Reader, length)
 }
 
-func generateAddress(params *types.GeneratorParams, idx int) AddressData {
-	var newWalletParams = []hdwallet.NewWalletOpt{
-		hdwallet.WithPassphrase(params.Passphrase),
-	}
-
-	if params.Mnemonic != "" {
-		newWalletParams = append(newWalletParams, hdwallet.WithMnemonic(params.Mnemonic))
+// GenerateMnemonicAndEntropyFrom is GenerateMnemonicAndEntropy,
+// but reads entropy from r.
+func GenerateMnemonicAndEntropyFrom(r io.Reader, length int) (mnemonic string, entropy []byte, err error) {
+	entropyLen, err := entropyBitsForLength(length)
+	if err != nil {
+		return
 	}
 
-	if params.Entropy != nil {
-		newWalletParams = append(newWalletParams, hdwallet.WithEntropy(params.Entropy))
+	entropy = make([]byte, entropyLen/8)
+	if _, err = io.ReadFull(r, entropy); err != nil {
+		err = wrapErr(ErrEntropySource, err)
+		return
 	}
 
-	return makeDerivedAddress(idx, params.GenName, newWalletParams...)
-}
-
-func GenerateMnemonic(length int) []string {
-	var entropyLen int
-	switch length {
-	case 12:
-		entropyLen = hdwallet.Entropy128Bit
-	case 15:
-		entropyLen = hdwallet.Entropy160Bit
-	case 18:
-		entropyLen = hdwallet.Entropy192Bit
-	case 21:
-		entropyLen = hdwallet.Entropy224Bit
-	case 24:
-		entropyLen = hdwallet.Entropy256Bit
+	mnemonic, err = bip39.NewMnemonic(entropy)
+	if err != nil {
+		err = wrapErr(ErrEntropySource, err)
+		return
 	}
 
-	entropy, _ := bip39.NewEntropy(entropyLen)
-	mnemonic, _ := bip39.NewMnemonic(entropy)
-
-	split := strings.Split(mnemonic, " ")
-	if len(split) != length {
-		panic("wtf")
+	if split := strings.Split(mnemonic, " "); len(split) != length {
+		err = wrapErr(ErrInvalidMnemonicLength, errors.Errorf("generated mnemonic has %d words, expected %d", len(split), length))
 	}
 
-	return split
+	return
 }
 
-func GenerateMnemonicAndEntropy(length int) (mnemonic string, entropy []byte) {
-	var entropyLen int
+func entropyBitsForLength(length int) (entropyBits int, err error) {
 	switch length {
 	case 12:
-		entropyLen = hdwallet.Entropy128Bit
+		entropyBits = hdwallet.Entropy128Bit
 	case 15:
-		entropyLen = hdwallet.Entropy160Bit
+		entropyBits = hdwallet.Entropy160Bit
 	case 18:
-		entropyLen = hdwallet.Entropy192Bit
+		entropyBits = hdwallet.Entropy192Bit
 	case 21:
-		entropyLen = hdwallet.Entropy224Bit
+		entropyBits = hdwallet.Entropy224Bit
 	case 24:
-		entropyLen = hdwallet.Entropy256Bit
-	}
-
-	entropy, _ = bip39.NewEntropy(entropyLen)
-	mnemonic, _ = bip39.NewMnemonic(entropy)
-
-	split := strings.Split(mnemonic, " ")
-	if len(split) != length {
-		panic("wtf")
+		entropyBits = hdwallet.Entropy256Bit
+	default:
+		err = wrapErr(ErrInvalidMnemonicLength, errors.Errorf("got %d; allowed values: 12 15 18 21 24", length))
 	}
 
 	return
 }
 
-func makeDerivedAddress(pathIdx int, genName bool, params ...hdwallet.NewWalletOpt) AddressData {
-	wallet, err := hdwallet.NewHDWallet(params...)
-	if err != nil {
-		panic(err)
-	}
-
-	derivedAccount, err := wallet.DeriveAddressFromIndex(pathIdx)
+func makeDerivedAddress(node *hdNode, pathIdx int, name string) (AddressData, error) {
+	rawAddrData, err := node.derive(pathIdx)
 	if err != nil {
-		panic(err)
-	}
-
-	rawAddrData := AddressData{
-		Address:        derivedAccount.Address().String(),
-		Entropy:        common.Bytes2Hex(wallet.Entropy()),
-		Seed:           common.Bytes2Hex(wallet.Seed()),
-		Mnemonic:       wallet.Mnemonic(),
-		PubKey:         derivedAccount.PublicKeyHex(),
-		PrivKey:        derivedAccount.PrivateKeyHex(),
-		WalletIndex:    derivedAccount.DerivationIndex(),
-		DerivationPath: derivedAccount.DerivationPath(),
-		Hardened:       derivedAccount.Hardened(),
+		return AddressData{}, err
 	}
 
-	if genName {
-		rawAddrData.Name = genNameFromMnemonic(rawAddrData.Mnemonic)
-	}
+	rawAddrData.Name = name
 
-	return rawAddrData
+	return rawAddrData, nil
 }
 
-func genNameFromMnemonic(mnemonic string) string {
+func genNameFromMnemonic(r io.Reader, mnemonic string) (string, error) {
 	split := strings.Split(mnemonic, " ")
 
-	getWord := func() string {
-		return split[rand.Intn(len(split))]
+	getWord := func() (string, error) {
+		idx, err := randomInt(r, 0, len(split)-1)
+		if err != nil {
+			return "", err
+		}
+
+		return split[idx], nil
+	}
+
+	n1, err := getWord()
+	if err != nil {
+		return "", err
 	}
 
-	var (
-		n1 = getWord()
-		n2 string
-	)
+	n2, err := getWord()
+	for err == nil && ((n2 == n1) || len(n2) < 5) {
+		n2, err = getWord()
+	}
 
-	for n2 = getWord(); (n2 == n1) || len(n2) < 5; {
-		n2 = getWord()
+	if err != nil {
+		return "", err
 	}
 
-	return n1 + " " + n2
+	return n1 + " " + n2, nil
 }
@@ -0,0 +1,172 @@
+package bip39gen
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil/bech32"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+// CardanoNetwork contains the parameters which distinguish
+// the addresses of a Cardano network from those of other networks.
+type CardanoNetwork struct {
+	// Name is the name of the network, e.g. "mainnet".
+	Name string
+	// ID is the network ID stored in the lower bits of address headers.
+	ID byte
+	// AddressHRP is the human-readable part of base addresses.
+	AddressHRP string
+	// StakeHRP is the human-readable part of stake addresses.
+	StakeHRP string
+}
+
+var cardanoNetworks = []CardanoNetwork{
+	{Name: "mainnet", ID: 1, AddressHRP: "addr", StakeHRP: "stake"},
+	{Name: "testnet", ID: 0, AddressHRP: "addr_test", StakeHRP: "stake_test"},
+}
+
+// Shelley address header types, stored in the upper bits of address headers.
+const (
+	cardanoBaseAddress  byte = 0b0000 << 4
+	cardanoStakeAddress byte = 0b1110 << 4
+)
+
+// cardanoStakeRole is the CIP-1852 role of stake keys.
+const cardanoStakeRole uint32 = 2
+
+// Cardano is the Chain for Cardano mainnet.
+// Use CardanoChain.WithNetwork to generate testnet addresses.
+var Cardano = CardanoChain{Network: cardanoNetworks[0]}
+
+// CardanoChain is a Chain for Cardano, deriving ed25519-bip32 keys from
+// the BIP39 entropy using Icarus master key generation, at CIP-1852 paths.
+//
+// Addresses are Shelley base addresses, combining the hashes of
+// the payment key at the derivation path and the stake key at role 2,
+// index 0 of the same account, and StakeAddress is the stake key's
+// reward address.
+// PrivKey is the 64-byte extended private key, and PrivKeyEncoded
+// and ExtendedPubKey are the payment key's addr_xsk and addr_xvk
+// encodings, which include its chain code.
+type CardanoChain struct {
+	// Network is the network addresses are generated for.
+	Network CardanoNetwork
+
+	icarusEngine
+}
+
+func (c CardanoChain) Name() string {
+	return "cardano"
+}
+
+// DefaultPathTemplate returns m/1852'/1815'/{account}'/0/{index}.
+func (c CardanoChain) DefaultPathTemplate() PathTemplate {
+	return MustParsePathTemplate(fmt.Sprintf("m/1852'/1815'/%s'/0/%s", AccountPlaceholder, IndexPlaceholder))
+}
+
+// Networks returns the names of the networks the chain supports.
+func (c CardanoChain) Networks() []string {
+	names := make([]string, len(cardanoNetworks))
+
+	for i, network := range cardanoNetworks {
+		names[i] = network.Name
+	}
+
+	return names
+}
+
+// WithNetwork returns a copy of the chain which generates
+// addresses for the named network.
+func (c CardanoChain) WithNetwork(network string) (CardanoChain, error) {
+	for _, n := range cardanoNetworks {
+		if n.Name == network {
+			c.Network = n
+			return c, nil
+		}
+	}
+
+	return CardanoChain{}, wrapErr(ErrUnsupportedChain, errors.Errorf(
+		"cardano has no network %q; supported networks: %v",
+		network, c.Networks(),
+	))
+}
+
+func (c CardanoChain) fillAddressData(key hdKey, ad *AddressData) error {
+	paymentKey := key.(icarusKey)
+
+	// payment keys are derived at account/role/index
+	if paymentKey.parent == nil || paymentKey.parent.parent == nil {
+		return wrapErr(ErrDerivation, errors.New("cardano derivation paths must end with role and index components"))
+	}
+
+	stakeKey, err := deriveIcarusPath(*paymentKey.parent.parent, cardanoStakeRole, 0)
+	if err != nil {
+		return wrapErr(ErrDerivation, err)
+	}
+
+	paymentPub := paymentKey.publicKey()
+
+	if ad.Address, ad.StakeAddress, err = c.addresses(paymentPub, stakeKey.publicKey()); err != nil {
+		return wrapErr(ErrDerivation, err)
+	}
+
+	var (
+		privKey = append(append([]byte{}, paymentKey.kL...), paymentKey.kR...)
+		xsk     = append(append([]byte{}, privKey...), paymentKey.chainCode...)
+		xvk     = append(append([]byte{}, paymentPub...), paymentKey.chainCode...)
+	)
+
+	if ad.PrivKeyEncoded, err = bech32.EncodeFromBase256("addr_xsk", xsk); err != nil {
+		return wrapErr(ErrDerivation, err)
+	}
+
+	if ad.ExtendedPubKey, err = bech32.EncodeFromBase256("addr_xvk", xvk); err != nil {
+		return wrapErr(ErrDerivation, err)
+	}
+
+	ad.Network = c.Network.Name
+	ad.PubKey = common.Bytes2Hex(paymentPub)
+	ad.PrivKey = common.Bytes2Hex(privKey)
+
+	return nil
+}
+
+// addresses returns the base address of a payment and stake key pair,
+// and the stake address of the stake key.
+func (c CardanoChain) addresses(paymentPub, stakePub []byte) (base, stake string, err error) {
+	var (
+		paymentHash = blake2bSum(28, paymentPub)
+		stakeHash   = blake2bSum(28, stakePub)
+	)
+
+	baseAddr := append([]byte{cardanoBaseAddress | c.Network.ID}, paymentHash...)
+	baseAddr = append(baseAddr, stakeHash...)
+
+	if base, err = bech32.EncodeFromBase256(c.Network.AddressHRP, baseAddr); err != nil {
+		return "", "", err
+	}
+
+	stakeAddr := append([]byte{cardanoStakeAddress | c.Network.ID}, stakeHash...)
+
+	if stake, err = bech32.EncodeFromBase256(c.Network.StakeHRP, stakeAddr); err != nil {
+		return "", "", err
+	}
+
+	return base, stake, nil
+}
+
+// deriveIcarusPath derives the descendant of key at the given path,
+// relative to key.
+func deriveIcarusPath(key icarusKey, path ...uint32) (icarusKey, error) {
+	for _, n := range path {
+		child, err := key.derive(n)
+		if err != nil {
+			return icarusKey{}, err
+		}
+
+		key = child.(icarusKey)
+	}
+
+	return key, nil
+}
@@ -0,0 +1,94 @@
+package bip39gen
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil/bech32"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestCardanoAddresses(t *testing.T) {
+	// CIP-19 test vectors
+	_, paymentPub, err := bech32.DecodeToBase256("addr_vk1w0l2sr2zgfm26ztc6nl9xy8ghsk5sh6ldwemlpmp9xylzy4dtf7st80zhd")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stakePub := common.Hex2Bytes("09ab278d49b7b86a055185c474c4942281ddfa05a54684c7e8a6f230625aee57")
+
+	tests := []struct {
+		network string
+		base    string
+		stake   string
+	}{
+		{
+			network: "mainnet",
+			base:    "addr1qx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer3n0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgse35a3x",
+			stake:   "stake1uyehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gh6ffgw",
+		},
+		{
+			network: "testnet",
+			base:    "addr_test1qz2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer3n0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgs68faae",
+			stake:   "stake_test1uqehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gssrtvn",
+		},
+	}
+
+	for _, tt := range tests {
+		chain, err := Cardano.WithNetwork(tt.network)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		base, stake, err := chain.addresses(paymentPub, stakePub)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if base != tt.base || stake != tt.stake {
+			t.Errorf("%s: got %s / %s, want %s / %s", tt.network, base, stake, tt.base, tt.stake)
+		}
+	}
+}
+
+func TestCardano(t *testing.T) {
+	tests := []struct {
+		name         string
+		mnemonic     string
+		address      string
+		stakeAddress string
+		pubKey       string
+	}{
+		{
+			// cardano-address and CIP-19's payment key
+			name:         "cardano-address",
+			mnemonic:     "test walk nut penalty hip pave soap entry language right filter choice",
+			address:      "addr1qx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer3jcu5d8ps7zex2k2xt3uqxgjqnnj83ws8lhrn648jjxtwqfjkjv7",
+			stakeAddress: "stake1uyevw2xnsc0pvn9t9r9c7qryfqfeerchgrlm3ea2nefr9hqxdekzz",
+			pubKey:       "73fea80d424276ad0978d4fe5310e8bc2d485f5f6bb3bf87612989f112ad5a7d",
+		},
+		{
+			// CIP-11's stake key
+			name:         "CIP-11",
+			mnemonic:     "prevent company field green slot measure chief hero apple task eagle sunset endorse dress seed",
+			stakeAddress: "stake1uy8ykk8dzmeqxm05znz65nhr80m0k3gxnjvdngf8azh6sjc6hyh36",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			addr := deriveTestAddress(t, Cardano, tt.mnemonic, 0)
+
+			if tt.address != "" && addr.Address != tt.address {
+				t.Errorf("got address %s, want %s", addr.Address, tt.address)
+			}
+
+			if addr.StakeAddress != tt.stakeAddress {
+				t.Errorf("got stake address %s, want %s", addr.StakeAddress, tt.stakeAddress)
+			}
+
+			if tt.pubKey != "" && addr.PubKey != tt.pubKey {
+				t.Errorf("got payment key %s, want %s", addr.PubKey, tt.pubKey)
+			}
+		})
+	}
+}
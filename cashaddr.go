@@ -0,0 +1,78 @@
+package bip39gen
+
+import (
+	"strings"
+
+	"github.com/btcsuite/btcd/btcutil/bech32"
+	"github.com/pkg/errors"
+)
+
+// cashAddrCharset is the base32 alphabet used by CashAddr, shared with bech32.
+const cashAddrCharset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+// cashAddrP2PKH is the CashAddr address type of P2PKH addresses,
+// stored in the upper bits of the version byte.
+const cashAddrP2PKH byte = 0
+
+// encodeCashAddr encodes a 160-bit hash as a CashAddr address,
+// e.g. bitcoincash:qr95sy3j9xwd2ap32xkykttr4cvcu7as4y0qverfuy.
+func encodeCashAddr(prefix string, addrType byte, hash []byte) (string, error) {
+	if len(hash) != 20 {
+		return "", errors.Errorf("cashaddr hash must be 20 bytes, got %d", len(hash))
+	}
+
+	// the size bits of the version byte are 0 for 160-bit hashes
+	payload, err := bech32.ConvertBits(append([]byte{addrType << 3}, hash...), 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+
+	checksum := cashAddrPolymod(prefix, payload)
+
+	var sb strings.Builder
+
+	sb.WriteString(prefix)
+	sb.WriteByte(':')
+
+	for _, b := range payload {
+		sb.WriteByte(cashAddrCharset[b])
+	}
+
+	for i := 0; i < 8; i++ {
+		sb.WriteByte(cashAddrCharset[(checksum>>(5*(7-i)))&0x1f])
+	}
+
+	return sb.String(), nil
+}
+
+// cashAddrPolymod computes the 40-bit CashAddr checksum of payload.
+// The lower 5 bits of each prefix character, a zero separator,
+// and 8 zeroed checksum groups are included in the computation.
+func cashAddrPolymod(prefix string, payload []byte) uint64 {
+	values := make([]byte, 0, len(prefix)+1+len(payload)+8)
+
+	for i := 0; i < len(prefix); i++ {
+		values = append(values, prefix[i]&0x1f)
+	}
+
+	values = append(values, 0)
+	values = append(values, payload...)
+	values = append(values, make([]byte, 8)...)
+
+	generators := [5]uint64{0x98f2bc8e61, 0x79b76d99e2, 0xf33e5fb3c4, 0xae2eabe2a8, 0x1e4f43e470}
+
+	c := uint64(1)
+
+	for _, d := range values {
+		c0 := c >> 35
+		c = ((c & 0x07ffffffff) << 5) ^ uint64(d)
+
+		for i, g := range generators {
+			if (c0>>i)&1 == 1 {
+				c ^= g
+			}
+		}
+	}
+
+	return c ^ 1
+}
@@ -0,0 +1,37 @@
+package bip39gen
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil/base58"
+)
+
+func TestEncodeCashAddr(t *testing.T) {
+	// test vectors from the CashAddr specification
+	tests := []struct {
+		legacy string
+		want   string
+	}{
+		{legacy: "1BpEi6DfDAUFd7GtittLSdBeYJvcoaVggu", want: "bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a"},
+		{legacy: "1KXrWXciRDZUpQwQmuM1DbwsKDLYAYsVLR", want: "bitcoincash:qr95sy3j9xwd2ap32xkykttr4cvcu7as4y0qverfuy"},
+		{legacy: "16w1D5WRVKJuZUsSRzdLp9w3YGcgoxDXb", want: "bitcoincash:qqq3728yw0y47sqn6l2na30mcw6zm78dzqre909m2r"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.legacy, func(t *testing.T) {
+			hash, _, err := base58.CheckDecode(tt.legacy)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := encodeCashAddr("bitcoincash", cashAddrP2PKH, hash)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
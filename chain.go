@@ -0,0 +1,99 @@
+package bip39gen
+
+import (
+	"sort"
+)
+
+// Chain describes how a blockchain's addresses are derived
+// from a BIP39 seed and encoded.
+type Chain interface {
+	// Name returns the name of the chain, e.g. "ethereum".
+	Name() string
+	// DefaultPathTemplate returns the template addresses are derived at
+	// unless the Generator was given a different one.
+	// It returns the zero PathTemplate for chains whose keys
+	// aren't derived from paths.
+	DefaultPathTemplate() PathTemplate
+	// newMasterKey returns the root of the key tree addresses are derived from.
+	newMasterKey(src keySource) (hdKey, error)
+	// fillAddressData sets the chain-specific fields of ad,
+	// such as its address and encoded keys, from a derived key.
+	fillAddressData(key hdKey, ad *AddressData) error
+}
+
+// AddressFormat is the encoding of addresses of chains
+// which support more than one.
+type AddressFormat string
+
+const (
+	// LegacyFormat addresses are base58check encoded.
+	LegacyFormat AddressFormat = "legacy"
+	// CashAddrFormat addresses are CashAddr encoded,
+	// e.g. bitcoincash:q...
+	CashAddrFormat AddressFormat = "cashaddr"
+	// ClassicFormat addresses are XRP Ledger classic addresses, e.g. r...
+	ClassicFormat AddressFormat = "classic"
+	// XAddressFormat addresses are XRP Ledger X-addresses, e.g. X...,
+	// which may include a destination tag.
+	XAddressFormat AddressFormat = "x-address"
+)
+
+// AddressFormats returns all AddressFormats.
+func AddressFormats() []AddressFormat {
+	return []AddressFormat{LegacyFormat, CashAddrFormat, ClassicFormat, XAddressFormat}
+}
+
+// chains contains every Chain selectable by name,
+// using its default options.
+var chains = newChainRegistry(
+	Ethereum,
+	EthereumValidator,
+	Bitcoin,
+	BitcoinCash,
+	Litecoin,
+	Dogecoin,
+	Dash,
+	Zcash,
+	Cosmos,
+	Tron,
+	XRP,
+	Solana,
+	Cardano,
+	Starknet,
+	Filecoin,
+	Aptos,
+	Sui,
+	Algorand,
+	Nostr,
+	Stellar,
+)
+
+func newChainRegistry(chainList ...Chain) map[string]Chain {
+	registry := make(map[string]Chain, len(chainList))
+
+	for _, chain := range chainList {
+		registry[chain.Name()] = chain
+	}
+
+	return registry
+}
+
+// ChainByName returns the Chain with the given name, using its default
+// options, and whether such a chain exists.
+func ChainByName(name string) (Chain, bool) {
+	chain, ok := chains[name]
+	return chain, ok
+}
+
+// ChainNames returns the names of all available Chains, sorted.
+func ChainNames() []string {
+	names := make([]string, 0, len(chains))
+
+	for name := range chains {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
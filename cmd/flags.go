@@ -1,12 +1,15 @@
 package main
 
 import (
+	"math"
 	"path/filepath"
 	"strings"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/urfave/cli/v2"
 
+	"github.com/jalavosus/bip39gen"
 	"github.com/jalavosus/bip39gen/internal/datakeys"
 	"github.com/jalavosus/bip39gen/internal/outformat"
 	"github.com/jalavosus/bip39gen/internal/types"
@@ -15,6 +18,9 @@ import (
 const (
 	defaultOutFormat     = outformat.JSON
 	defaultNum       int = 3
+	defaultMinIndex  int = 1
+	defaultWorkers   int = 1
+	defaultMaxIndex  int = 1000
 )
 
 const (
@@ -23,13 +29,48 @@ const (
 )
 
 var defaultOutExcludes = map[string]bool{
-	datakeys.Mnemonic:       false,
-	datakeys.Entropy:        false,
-	datakeys.Privkey:        false,
-	datakeys.Pubkey:         false,
-	datakeys.Seed:           false,
-	datakeys.WalletIndex:    false,
-	datakeys.DerivationPath: false,
+	datakeys.Mnemonic:          false,
+	datakeys.Entropy:           false,
+	datakeys.Privkey:           false,
+	datakeys.PrivkeyEncoded:    false,
+	datakeys.Pubkey:            false,
+	datakeys.TweakedPubkey:     false,
+	datakeys.Seed:              false,
+	datakeys.WalletIndex:       false,
+	datakeys.DerivationPath:    false,
+	datakeys.PathPreset:        false,
+	datakeys.Chain:             false,
+	datakeys.Network:           false,
+	datakeys.AddressType:       false,
+	datakeys.AddressFormat:     false,
+	datakeys.AddressHex:        false,
+	datakeys.StakeAddress:      false,
+	datakeys.ExtendedPubkey:    false,
+	datakeys.WithdrawalPubkey:  false,
+	datakeys.WithdrawalPrivkey: false,
+}
+
+// excludableFields are the output fields which may be passed to --exclude.
+var excludableFields = []string{
+	datakeys.Mnemonic,
+	datakeys.Seed,
+	datakeys.Entropy,
+	datakeys.Privkey,
+	datakeys.PrivkeyEncoded,
+	datakeys.Pubkey,
+	datakeys.TweakedPubkey,
+	datakeys.WalletIndex,
+	datakeys.DerivationPath,
+	datakeys.PathPreset,
+	datakeys.Chain,
+	datakeys.Network,
+	datakeys.AddressType,
+	datakeys.AddressFormat,
+	datakeys.AddressHex,
+	datakeys.StakeAddress,
+	datakeys.ExtendedPubkey,
+	datakeys.WithdrawalPubkey,
+	datakeys.WithdrawalPrivkey,
 }
 
 var (
@@ -45,7 +86,7 @@ var (
 		StringFlag: &cli.StringFlag{
 			Name:     "format",
 			Aliases:  []string{"f"},
-			Usage:    "`format` to use for outputting data to. Allowed values: json,yaml,text",
+			Usage:    "`format` to use for outputting data to. Allowed values: json,yaml,toml,text,ndjson,csv. text, ndjson, and csv output is streamed as addresses are generated.",
 			Required: false,
 			Value:    defaultOutFormat.String(),
 			Category: categoryOutput,
@@ -55,6 +96,8 @@ var (
 			outformat.YAML.String(),
 			outformat.TOML.String(),
 			outformat.Text.String(),
+			outformat.NDJSON.String(),
+			outformat.CSV.String(),
 		},
 	}
 
@@ -62,21 +105,13 @@ var (
 		StringFlag: &cli.StringFlag{
 			Name:     "exclude",
 			Aliases:  []string{"e"},
-			Usage:    "comma-separated list of `values` to exclude from output. Allowed values: mnemonic,seed,entropy,privkey,pubkey,wallet_index,derivation_path",
+			Usage:    "comma-separated list of `values` to exclude from output. Allowed values: " + strings.Join(excludableFields, ","),
 			Required: false,
 			Value:    "",
 			Category: categoryOutput,
 		},
-		AllowEmpty: true,
-		AllowedValues: []string{
-			datakeys.Mnemonic,
-			datakeys.Seed,
-			datakeys.Entropy,
-			datakeys.Privkey,
-			datakeys.Pubkey,
-			datakeys.WalletIndex,
-			datakeys.DerivationPath,
-		},
+		AllowEmpty:    true,
+		AllowedValues: excludableFields,
 	}
 
 	numAddressesFlag = cli.IntFlag{
@@ -142,6 +177,134 @@ var (
 		Category: categoryGenParams,
 	}
 
+	minIndexFlag = cli.IntFlag{
+		Name:     "min-index",
+		Usage:    "[Optional] lowest `index` randomly chosen wallet indices are picked from.",
+		Required: false,
+		Value:    defaultMinIndex,
+		Category: categoryGenParams,
+	}
+
+	maxIndexFlag = cli.IntFlag{
+		Name:     "max-index",
+		Usage:    "[Optional] highest `index` randomly chosen wallet indices are picked from.",
+		Required: false,
+		Value:    defaultMaxIndex,
+		Category: categoryGenParams,
+	}
+
+	workersFlag = cli.IntFlag{
+		Name:     "workers",
+		Aliases:  []string{"w"},
+		Usage:    "[Optional] number of `workers` generating addresses concurrently. 0 uses one worker per CPU.",
+		Required: false,
+		Value:    defaultWorkers,
+		Category: categoryGenParams,
+	}
+
+	chainFlag = AllowedStringValuesFlag{
+		StringFlag: &cli.StringFlag{
+			Name:     "chain",
+			Aliases:  []string{"c"},
+			Usage:    "`chain` to generate addresses for. Allowed values: " + strings.Join(bip39gen.ChainNames(), ","),
+			Required: false,
+			Value:    bip39gen.Ethereum.Name(),
+			Category: categoryGenParams,
+		},
+		AllowedValues: bip39gen.ChainNames(),
+	}
+
+	networkFlag = cli.StringFlag{
+		Name:     "network",
+		Usage:    "[Optional] `network` to generate addresses for, for bitcoin-like chains, e.g. mainnet, testnet, signet, regtest.",
+		Required: false,
+		Value:    "mainnet",
+		Category: categoryGenParams,
+	}
+
+	addressTypeFlag = cli.StringFlag{
+		Name:     "address-type",
+		Usage:    "[Optional] `type` of address to generate. Defaults to the chain's standard type. Allowed values for bitcoin-like chains: " + bitcoinAddressTypes() + ". Allowed values for cosmos: " + cosmosAddressTypes(),
+		Required: false,
+		Value:    "",
+		Category: categoryGenParams,
+	}
+
+	addressFormatFlag = cli.StringFlag{
+		Name:     "address-format",
+		Usage:    "[Optional] `format` of generated addresses, for chains supporting more than one, e.g. bitcoin-cash and xrp. Defaults to the chain's standard format. Allowed values: " + addressFormats(),
+		Required: false,
+		Value:    "",
+		Category: categoryGenParams,
+	}
+
+	hrpFlag = cli.StringFlag{
+		Name:     "hrp",
+		Usage:    "[Optional] bech32 human-readable `prefix` of cosmos addresses, e.g. cosmos, osmo, juno.",
+		Required: false,
+		Value:    bip39gen.Cosmos.HRP,
+		Category: categoryGenParams,
+	}
+
+	destinationTagFlag = cli.UintFlag{
+		Name:     "destination-tag",
+		Usage:    "[Optional] destination `tag` to encode into xrp X-addresses. Implies --address-format x-address.",
+		Required: false,
+		Category: categoryGenParams,
+	}
+
+	pathFlag = cli.StringFlag{
+		Name:     "path",
+		Usage:    "[Optional] derivation path `template`, e.g. m/44'/60'/{account}'/0/{index}. Hardened components may use ' or h notation. Defaults to the chain's standard path.",
+		Required: false,
+		Value:    "",
+		Category: categoryGenParams,
+	}
+
+	pathPresetFlag = AllowedStringValuesFlag{
+		StringFlag: &cli.StringFlag{
+			Name:     "path-preset",
+			Usage:    "[Optional] derive addresses the same way the named `wallet` does. Allowed values: " + strings.Join(bip39gen.PathPresetNames(), ","),
+			Required: false,
+			Value:    "",
+			Category: categoryGenParams,
+		},
+		AllowEmpty:    true,
+		AllowedValues: bip39gen.PathPresetNames(),
+	}
+
+	starkLayerFlag = cli.StringFlag{
+		Name:     "stark-layer",
+		Usage:    "[Optional] EIP-2645 `layer` name of starknet derivation paths. Requires --eth-address.",
+		Required: false,
+		Value:    "starkex",
+		Category: categoryGenParams,
+	}
+
+	starkApplicationFlag = cli.StringFlag{
+		Name:     "stark-application",
+		Usage:    "[Optional] EIP-2645 `application` name of starknet derivation paths, e.g. starkdeployement. Requires --eth-address.",
+		Required: false,
+		Value:    "",
+		Category: categoryGenParams,
+	}
+
+	ethAddressFlag = cli.StringFlag{
+		Name:     "eth-address",
+		Usage:    "[Optional] Ethereum `address` of EIP-2645 starknet derivation paths. If set, starknet keys are derived at m/2645'/layer'/application'/eth_address_1'/eth_address_2'/{index}.",
+		Required: false,
+		Value:    "",
+		Category: categoryGenParams,
+	}
+
+	accountFlag = cli.UintFlag{
+		Name:     "account",
+		Usage:    "[Optional] `account` index substituted for {account} in the derivation path template.",
+		Required: false,
+		Value:    0,
+		Category: categoryGenParams,
+	}
+
 	sequentialIndexFlag = cli.BoolFlag{
 		Name:     "sequential-index",
 		Usage:    "[Optional] If true and --single-mnemonic is true, addresses are generated with sequential wallet indices.",
@@ -151,6 +314,16 @@ var (
 	}
 )
 
+func bitcoinAddressTypes() string {
+	var types []string
+
+	for _, t := range bip39gen.BitcoinAddressTypes() {
+		types = append(types, string(t))
+	}
+
+	return strings.Join(types, ",")
+}
+
 func parseFlags(c *cli.Context) (params types.CLIParams, err error) {
 	rawExcludes := strings.Split(
 		strings.Replace(outExcludesFlag.Get(c), " ", "", -1),
@@ -200,6 +373,22 @@ func parseFlags(c *cli.Context) (params types.CLIParams, err error) {
 
 	sequentialIndices := sequentialIndexFlag.Get(c) && oneMnemonicFlag.Get(c)
 
+	chain, err := parseChainFlags(c)
+	if err != nil {
+		return
+	}
+
+	pathTemplate, pathPreset, err := parsePathFlags(c, chain)
+	if err != nil {
+		return
+	}
+
+	account := accountFlag.Get(c)
+	if account > math.MaxUint32 {
+		err = errors.Errorf("--%s must be at most %d", accountFlag.Name, uint32(math.MaxUint32))
+		return
+	}
+
 	params = types.CLIParams{
 		Num:               num,
 		OutfilePath:       outFile,
@@ -212,11 +401,252 @@ func parseFlags(c *cli.Context) (params types.CLIParams, err error) {
 		GenName:           genNameFlag.Get(c),
 		Hardened:          hardendedFlag.Get(c),
 		SequentialIndex:   sequentialIndices,
+		MinIndex:          minIndexFlag.Get(c),
+		MaxIndex:          maxIndexFlag.Get(c),
+		Workers:           workersFlag.Get(c),
+		Chain:             chain,
+		PathTemplate:      pathTemplate,
+		PathPreset:        pathPreset,
+		Account:           uint32(account),
 	}
 
 	return
 }
 
+func parseChainFlags(c *cli.Context) (chain bip39gen.Chain, err error) {
+	chainName := chainFlag.Get(c)
+
+	chain, ok := bip39gen.ChainByName(chainName)
+	if !ok {
+		_, err = checkAllowedValue(chainFlag.Name, chainName, chainFlag.AllowedValues)
+		return
+	}
+
+	switch ch := chain.(type) {
+	case bip39gen.UTXOChain:
+		return parseUTXOChainFlags(c, ch)
+	case bip39gen.CosmosChain:
+		return parseCosmosChainFlags(c, ch)
+	case bip39gen.XRPChain:
+		return parseXRPChainFlags(c, ch)
+	case bip39gen.CardanoChain:
+		return parseCardanoChainFlags(c, ch)
+	default:
+		err = checkUnsupportedChainFlags(c, chainName)
+	}
+
+	return
+}
+
+// checkUnsupportedChainFlags returns an error if any chain-specific flag
+// other than those named in supported was passed.
+func checkUnsupportedChainFlags(c *cli.Context, chainName string, supported ...string) error {
+	for _, name := range chainSpecificFlags() {
+		if !c.IsSet(name) {
+			continue
+		}
+
+		var ok bool
+
+		for _, s := range supported {
+			ok = ok || s == name
+		}
+
+		if !ok {
+			return errors.Errorf("--%s is not supported for chain %s", name, chainName)
+		}
+	}
+
+	return nil
+}
+
+// chainSpecificFlags returns the names of flags which configure a specific chain.
+func chainSpecificFlags() []string {
+	return []string{
+		networkFlag.Name,
+		addressTypeFlag.Name,
+		addressFormatFlag.Name,
+		hrpFlag.Name,
+		destinationTagFlag.Name,
+	}
+}
+
+func parseUTXOChainFlags(c *cli.Context, chain bip39gen.UTXOChain) (_ bip39gen.Chain, err error) {
+	err = checkUnsupportedChainFlags(c, chain.Name(), networkFlag.Name, addressTypeFlag.Name, addressFormatFlag.Name)
+	if err != nil {
+		return
+	}
+
+	if c.IsSet(networkFlag.Name) {
+		if chain, err = chain.WithNetwork(networkFlag.Get(c)); err != nil {
+			return
+		}
+	}
+
+	if c.IsSet(addressTypeFlag.Name) {
+		addressType := bip39gen.BitcoinAddressType(addressTypeFlag.Get(c))
+		if chain, err = chain.WithAddressType(addressType); err != nil {
+			return
+		}
+	}
+
+	if c.IsSet(addressFormatFlag.Name) {
+		addressFormat := bip39gen.AddressFormat(addressFormatFlag.Get(c))
+		if chain, err = chain.WithAddressFormat(addressFormat); err != nil {
+			return
+		}
+	}
+
+	return chain, nil
+}
+
+func parseCosmosChainFlags(c *cli.Context, chain bip39gen.CosmosChain) (_ bip39gen.Chain, err error) {
+	err = checkUnsupportedChainFlags(c, chain.Name(), addressTypeFlag.Name, hrpFlag.Name)
+	if err != nil {
+		return
+	}
+
+	if c.IsSet(hrpFlag.Name) {
+		if chain, err = chain.WithHRP(hrpFlag.Get(c)); err != nil {
+			return
+		}
+	}
+
+	if c.IsSet(addressTypeFlag.Name) {
+		addressType := bip39gen.CosmosAddressType(addressTypeFlag.Get(c))
+		if chain, err = chain.WithAddressType(addressType); err != nil {
+			return
+		}
+	}
+
+	return chain, nil
+}
+
+func parseXRPChainFlags(c *cli.Context, chain bip39gen.XRPChain) (_ bip39gen.Chain, err error) {
+	err = checkUnsupportedChainFlags(c, chain.Name(), addressFormatFlag.Name, destinationTagFlag.Name)
+	if err != nil {
+		return
+	}
+
+	if c.IsSet(addressFormatFlag.Name) {
+		addressFormat := bip39gen.AddressFormat(addressFormatFlag.Get(c))
+		if chain, err = chain.WithAddressFormat(addressFormat); err != nil {
+			return
+		}
+	}
+
+	if c.IsSet(destinationTagFlag.Name) {
+		if chain.AddressFormat != bip39gen.XAddressFormat && c.IsSet(addressFormatFlag.Name) {
+			err = errors.Errorf("--%s requires --%s %s", destinationTagFlag.Name, addressFormatFlag.Name, bip39gen.XAddressFormat)
+			return
+		}
+
+		tag := destinationTagFlag.Get(c)
+		if tag > math.MaxUint32 {
+			err = errors.Errorf("--%s must be at most %d", destinationTagFlag.Name, uint32(math.MaxUint32))
+			return
+		}
+
+		chain = chain.WithDestinationTag(uint32(tag))
+	}
+
+	return chain, nil
+}
+
+func parseCardanoChainFlags(c *cli.Context, chain bip39gen.CardanoChain) (_ bip39gen.Chain, err error) {
+	err = checkUnsupportedChainFlags(c, chain.Name(), networkFlag.Name)
+	if err != nil {
+		return
+	}
+
+	if c.IsSet(networkFlag.Name) {
+		if chain, err = chain.WithNetwork(networkFlag.Get(c)); err != nil {
+			return
+		}
+	}
+
+	return chain, nil
+}
+
+func parsePathFlags(c *cli.Context, chain bip39gen.Chain) (pathTemplate *bip39gen.PathTemplate, pathPreset *bip39gen.PathPreset, err error) {
+	if c.IsSet(pathFlag.Name) {
+		var t bip39gen.PathTemplate
+
+		t, err = bip39gen.ParsePathTemplate(pathFlag.Get(c))
+		if err != nil {
+			return
+		}
+
+		pathTemplate = &t
+	}
+
+	if c.IsSet(ethAddressFlag.Name) || c.IsSet(starkLayerFlag.Name) || c.IsSet(starkApplicationFlag.Name) {
+		if pathTemplate != nil || pathPresetFlag.Get(c) != "" {
+			err = errors.Errorf("EIP-2645 flags can't be combined with --%[1]s or --%[2]s", pathFlag.Name, pathPresetFlag.Name)
+			return
+		}
+
+		var t bip39gen.PathTemplate
+
+		if t, err = parseEIP2645Flags(c, chain); err != nil {
+			return
+		}
+
+		pathTemplate = &t
+	}
+
+	if presetName := pathPresetFlag.Get(c); presetName != "" {
+		if pathTemplate != nil {
+			err = errors.Errorf("only one of --%[1]s and --%[2]s may be provided", pathFlag.Name, pathPresetFlag.Name)
+			return
+		}
+
+		if chain != bip39gen.Ethereum {
+			err = errors.Errorf("--%[1]s is only supported for chain %[2]s", pathPresetFlag.Name, bip39gen.Ethereum.Name())
+			return
+		}
+
+		preset, ok := bip39gen.PathPresetByName(presetName)
+		if !ok {
+			_, err = checkAllowedValue(pathPresetFlag.Name, presetName, pathPresetFlag.AllowedValues)
+			return
+		}
+
+		if hardendedFlag.Get(c) && !preset.Template.IndexHardened(false) {
+			err = errors.Errorf("--%[1]s can't be combined with --%[2]s %[3]s, whose index isn't hardened", hardendedFlag.Name, pathPresetFlag.Name, preset.Name)
+			return
+		}
+
+		pathPreset = &preset
+	}
+
+	return
+}
+
+func parseEIP2645Flags(c *cli.Context, chain bip39gen.Chain) (bip39gen.PathTemplate, error) {
+	if chain != bip39gen.Starknet {
+		return bip39gen.PathTemplate{}, errors.Errorf(
+			"--%[1]s, --%[2]s, and --%[3]s are only supported for chain %[4]s",
+			ethAddressFlag.Name, starkLayerFlag.Name, starkApplicationFlag.Name, bip39gen.Starknet.Name(),
+		)
+	}
+
+	var (
+		ethAddress  = ethAddressFlag.Get(c)
+		application = starkApplicationFlag.Get(c)
+	)
+
+	if !common.IsHexAddress(ethAddress) {
+		return bip39gen.PathTemplate{}, errors.Errorf("--%s must be a hex Ethereum address, got %q", ethAddressFlag.Name, ethAddress)
+	}
+
+	if application == "" {
+		return bip39gen.PathTemplate{}, errors.Errorf("--%s is required with --%s", starkApplicationFlag.Name, ethAddressFlag.Name)
+	}
+
+	return bip39gen.EIP2645PathTemplate(starkLayerFlag.Get(c), application, common.HexToAddress(ethAddress)), nil
+}
+
 func validateMnemonicLength(c *cli.Context) (err error) {
 	ml := mnemonicLenFlag.Get(c)
 
@@ -233,3 +663,23 @@ func validateMnemonicLength(c *cli.Context) (err error) {
 	return
 
 }
+
+func addressFormats() string {
+	var formats []string
+
+	for _, f := range bip39gen.AddressFormats() {
+		formats = append(formats, string(f))
+	}
+
+	return strings.Join(formats, ",")
+}
+
+func cosmosAddressTypes() string {
+	var types []string
+
+	for _, t := range bip39gen.CosmosAddressTypes() {
+		types = append(types, string(t))
+	}
+
+	return strings.Join(types, ",")
+}
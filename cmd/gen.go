@@ -30,6 +30,21 @@ var genCmd = cli.Command{
 		&genNameFlag,
 		&hardendedFlag,
 		&sequentialIndexFlag,
+		&minIndexFlag,
+		&maxIndexFlag,
+		&workersFlag,
+		&chainFlag,
+		&networkFlag,
+		&addressTypeFlag,
+		&addressFormatFlag,
+		&hrpFlag,
+		&destinationTagFlag,
+		&pathFlag,
+		&pathPresetFlag,
+		&starkLayerFlag,
+		&starkApplicationFlag,
+		&ethAddressFlag,
+		&accountFlag,
 	},
 	Action: genCmdAction,
 }
@@ -41,40 +56,71 @@ func genCmdAction(c *cli.Context) error {
 	}
 
 	var (
-		addrs      = make([]bip39gen.AddressData, params.Num)
-		addrsCheck = make(map[string]bool)
+		addrs  []bip39gen.AddressData
+		genErr error
+		gen    = bip39gen.NewGenerator(generatorOpts(params)...)
 	)
 
-	genParams := params.GeneratorParams()
+	if params.OutFormat.Streamable() {
+		var results <-chan bip39gen.StreamResult
+
+		if oneMnemonicFlag.Get(c) {
+			results = gen.StreamSingleMnemonic(c.Context, params.Num)
+		} else {
+			results = gen.Stream(c.Context, params.Num)
+		}
+
+		return streamDataOut(results, params)
+	}
 
 	if oneMnemonicFlag.Get(c) {
-		genParams.Mnemonic, genParams.Entropy = bip39gen.GenerateMnemonicAndEntropy(genParams.MnemonicLen)
-		addrs = bip39gen.GenerateAddressesSingleMnemonic(genParams, params.Num)
+		addrs, genErr = gen.GenerateAddressesSingleMnemonic(params.Num)
 	} else {
-		for i := 0; i < params.Num; i++ {
-			addr := bip39gen.GenerateAddress(genParams)
-
-			// make sure we never get the same address
-			_, ok := addrsCheck[addr.Address]
-			for ok {
-				addr = bip39gen.GenerateAddress(genParams)
-				_, ok = addrsCheck[addr.Address]
-			}
-
-			addrs[i] = addr
-			addrsCheck[addr.Address] = true
-		}
+		addrs, genErr = gen.GenerateAddresses(params.Num)
+	}
+
+	if genErr != nil {
+		return genErr
 	}
 
 	formattedAddrs := make(bip39gen.AddressDataOutputSlice, len(addrs))
 
 	for i, addr := range addrs {
-		formattedAddrs[i] = addr.FormatOutput(genParams.Excludes)
+		formattedAddrs[i] = addr.FormatOutput(params.ExcludeFromOutput)
 	}
 
 	return writeDataOut(formattedAddrs, params)
 }
 
+func generatorOpts(params types.CLIParams) []bip39gen.GeneratorOpt {
+	var indexStrategy = bip39gen.RandomIndex
+	if params.SequentialIndex {
+		indexStrategy = bip39gen.SequentialIndex
+	}
+
+	opts := []bip39gen.GeneratorOpt{
+		bip39gen.WithPassphrase(params.Passphrase),
+		bip39gen.WithMnemonic(params.Mnemonic),
+		bip39gen.WithMnemonicLength(params.MnemonicLength),
+		bip39gen.WithHardened(params.Hardened),
+		bip39gen.WithIndexStrategy(indexStrategy),
+		bip39gen.WithIndexRange(params.MinIndex, params.MaxIndex),
+		bip39gen.WithGenName(params.GenName),
+		bip39gen.WithWorkers(params.Workers),
+		bip39gen.WithChain(params.Chain),
+		bip39gen.WithAccount(params.Account),
+	}
+
+	switch {
+	case params.PathPreset != nil:
+		opts = append(opts, bip39gen.WithPathPreset(*params.PathPreset))
+	case params.PathTemplate != nil:
+		opts = append(opts, bip39gen.WithPathTemplate(*params.PathTemplate))
+	}
+
+	return opts
+}
+
 func writeDataOut(data bip39gen.AddressDataOutputSlice, params types.CLIParams) error {
 	var (
 		marshaled []byte
@@ -98,23 +144,65 @@ func writeDataOut(data bip39gen.AddressDataOutputSlice, params types.CLIParams)
 		marshaled = formatter.FormatText()
 	}
 
-	var out *os.File
-	if params.OutfilePath == "" {
-		out = os.Stdout
-	} else {
-		var err error
+	out, closeOut, err := openOutFile(params)
+	if err != nil {
+		return err
+	}
+
+	defer closeOut()
+
+	_, err = out.WriteString(string(marshaled))
+
+	return err
+}
+
+func streamDataOut(results <-chan bip39gen.StreamResult, params types.CLIParams) error {
+	out, closeOut, err := openOutFile(params)
+	if err != nil {
+		return err
+	}
+
+	defer closeOut()
+
+	var format bip39gen.StreamFormat
+
+	switch params.OutFormat {
+	case outformat.NDJSON:
+		format = bip39gen.StreamNDJSON
+	case outformat.CSV:
+		format = bip39gen.StreamCSV
+	case outformat.Text:
+		format = bip39gen.StreamText
+	}
+
+	w := bip39gen.NewStreamWriter(out, format, params.ExcludeFromOutput)
 
-		out, err = os.OpenFile(params.OutfilePath, os.O_RDWR|os.O_CREATE, 0755)
-		if err != nil {
+	for res := range results {
+		if res.Err != nil {
+			return res.Err
+		}
+
+		if err = w.Write(res.Address.FormatOutput(params.ExcludeFromOutput)); err != nil {
 			return err
 		}
+	}
+
+	return nil
+}
 
-		defer func() {
-			_ = out.Close()
-		}()
+func openOutFile(params types.CLIParams) (out *os.File, closeOut func(), err error) {
+	if params.OutfilePath == "" {
+		return os.Stdout, func() {}, nil
 	}
 
-	_, err := out.WriteString(string(marshaled))
+	out, err = os.OpenFile(params.OutfilePath, os.O_RDWR|os.O_CREATE, 0755)
+	if err != nil {
+		return
+	}
 
-	return err
+	closeOut = func() {
+		_ = out.Close()
+	}
+
+	return
 }
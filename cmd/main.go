@@ -10,7 +10,7 @@ import (
 func main() {
 	app := &cli.App{
 		Name:  "bip39gen",
-		Usage: "Generate ethereum addresses which all use separate and randomly-generated seeds, entropy, and mnemonics",
+		Usage: "Generate ethereum, bitcoin, solana, and other chains' addresses which all use separate and randomly-generated seeds, entropy, and mnemonics",
 		Commands: []*cli.Command{
 			&genCmd,
 		},
@@ -0,0 +1,129 @@
+package bip39gen
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/bech32"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+// CosmosAddressType is the type of address a CosmosChain generates.
+// Every type encodes the same key hash, using a different
+// human-readable part suffix.
+type CosmosAddressType string
+
+const (
+	// CosmosAccount addresses are account addresses, e.g. cosmos1...
+	CosmosAccount CosmosAddressType = "account"
+	// CosmosValoper addresses are validator operator addresses, e.g. cosmosvaloper1...
+	CosmosValoper CosmosAddressType = "valoper"
+	// CosmosValcons addresses are validator consensus addresses, e.g. cosmosvalcons1...
+	// Validators usually sign blocks using a separate ed25519 key,
+	// so these are only useful for chains whose consensus keys
+	// are secp256k1 keys derived from a mnemonic.
+	CosmosValcons CosmosAddressType = "valcons"
+)
+
+// hrpSuffix returns the suffix appended to a chain's account
+// human-readable part for addresses of type t.
+func (t CosmosAddressType) hrpSuffix() string {
+	if t == CosmosAccount {
+		return ""
+	}
+
+	return string(t)
+}
+
+// CosmosAddressTypes returns all CosmosAddressTypes.
+func CosmosAddressTypes() []CosmosAddressType {
+	return []CosmosAddressType{CosmosAccount, CosmosValoper, CosmosValcons}
+}
+
+// Cosmos is the Chain for the Cosmos Hub, generating account addresses.
+// Use CosmosChain.WithHRP to generate addresses for other Cosmos SDK chains,
+// e.g. osmo or juno, and CosmosChain.WithAddressType to generate validator addresses.
+var Cosmos = CosmosChain{
+	HRP:         "cosmos",
+	AddressType: CosmosAccount,
+}
+
+// CosmosChain is a Chain for Cosmos SDK chains, which derive secp256k1 keys
+// at m/44'/118'/0'/0/{index} and encode RIPEMD160(SHA256(compressed pubkey))
+// as bech32.
+type CosmosChain struct {
+	// HRP is the human-readable part of account addresses, e.g. "cosmos".
+	HRP string
+	// AddressType is the type of address generated.
+	AddressType CosmosAddressType
+
+	bip32Engine
+}
+
+func (c CosmosChain) Name() string {
+	return "cosmos"
+}
+
+// DefaultPathTemplate returns m/44'/118'/{account}'/0/{index}.
+func (c CosmosChain) DefaultPathTemplate() PathTemplate {
+	return MustParsePathTemplate(fmt.Sprintf("m/44'/118'/%s'/0/%s", AccountPlaceholder, IndexPlaceholder))
+}
+
+// WithHRP returns a copy of the chain which generates addresses
+// with the given account human-readable part.
+func (c CosmosChain) WithHRP(hrp string) (CosmosChain, error) {
+	if hrp == "" {
+		return CosmosChain{}, wrapErr(ErrUnsupportedChain, errors.New("cosmos hrp must not be empty"))
+	}
+
+	for _, r := range hrp {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') {
+			return CosmosChain{}, wrapErr(ErrUnsupportedChain, errors.Errorf(
+				"cosmos hrp %q must only contain lowercase letters and digits", hrp,
+			))
+		}
+	}
+
+	c.HRP = hrp
+
+	return c, nil
+}
+
+// WithAddressType returns a copy of the chain which generates
+// addresses of the given type.
+func (c CosmosChain) WithAddressType(addressType CosmosAddressType) (CosmosChain, error) {
+	for _, t := range CosmosAddressTypes() {
+		if t == addressType {
+			c.AddressType = addressType
+			return c, nil
+		}
+	}
+
+	return CosmosChain{}, wrapErr(ErrUnsupportedChain, errors.Errorf(
+		"cosmos does not support %q addresses", addressType,
+	))
+}
+
+func (c CosmosChain) fillAddressData(key hdKey, ad *AddressData) error {
+	privKey, err := bip32PrivKey(key)
+	if err != nil {
+		return err
+	}
+
+	pubKey := privKey.PubKey().SerializeCompressed()
+
+	ad.Address, err = bech32.EncodeFromBase256(c.HRP+c.AddressType.hrpSuffix(), btcutil.Hash160(pubKey))
+	if err != nil {
+		return wrapErr(ErrDerivation, err)
+	}
+
+	// every Cosmos SDK chain shares the chain name "cosmos",
+	// so the account HRP identifies which one the address is for
+	ad.Network = c.HRP
+	ad.AddressType = string(c.AddressType)
+	ad.PubKey = common.Bytes2Hex(pubKey)
+	ad.PrivKey = common.Bytes2Hex(privKey.Serialize())
+
+	return nil
+}
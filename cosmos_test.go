@@ -0,0 +1,35 @@
+package bip39gen
+
+import (
+	"testing"
+)
+
+func TestCosmos(t *testing.T) {
+	tests := []struct {
+		hrp         string
+		addressType CosmosAddressType
+		address     string
+	}{
+		{"cosmos", CosmosAccount, "cosmos19rl4cm2hmr8afy4kldpxz3fka4jguq0auqdal4"},
+		{"cosmos", CosmosValoper, "cosmosvaloper19rl4cm2hmr8afy4kldpxz3fka4jguq0ae5egnx"},
+		{"osmo", CosmosAccount, "osmo19rl4cm2hmr8afy4kldpxz3fka4jguq0a5m7df8"},
+	}
+
+	for _, tt := range tests {
+		chain, err := Cosmos.WithHRP(tt.hrp)
+		if err == nil {
+			chain, err = chain.WithAddressType(tt.addressType)
+		}
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		addr := deriveTestAddress(t, chain, testMnemonic, 0)
+
+		if addr.Address != tt.address || addr.Network != tt.hrp || addr.Chain != "cosmos" {
+			t.Errorf("%s %s: got %s on %s %s, want %s on cosmos %s",
+				tt.hrp, tt.addressType, addr.Address, addr.Chain, addr.Network, tt.address, tt.hrp)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package bip39gen
+
+import (
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrInvalidMnemonicLength is returned when a mnemonic length other than
+	// 12, 15, 18, 21, or 24 is requested.
+	ErrInvalidMnemonicLength = errors.New("invalid mnemonic length")
+	// ErrDerivation is returned when a wallet or address can't be derived
+	// from the provided or generated BIP39 data.
+	ErrDerivation = errors.New("error deriving address")
+	// ErrInvalidIndexRange is returned when the range random derivation indices
+	// are picked from is empty or contains hardened indices.
+	ErrInvalidIndexRange = errors.New("invalid derivation index range")
+	// ErrInvalidPathTemplate is returned when a derivation path template
+	// can't be parsed.
+	ErrInvalidPathTemplate = errors.New("invalid derivation path template")
+	// ErrUnsupportedChain is returned when a chain, network, or address type
+	// isn't supported.
+	ErrUnsupportedChain = errors.New("unsupported chain")
+	// ErrInvalidAddressCount is returned when a negative number
+	// of addresses is requested.
+	ErrInvalidAddressCount = errors.New("invalid address count")
+	// ErrEntropySource is returned when entropy can't be read
+	// from the randomness source.
+	ErrEntropySource = errors.New("error reading entropy")
+)
+
+// generatorError wraps an underlying error with one of the
+// package's sentinel errors, so that both can be matched using errors.Is.
+type generatorError struct {
+	kind  error
+	cause error
+}
+
+func wrapErr(kind, cause error) error {
+	return &generatorError{
+		kind:  kind,
+		cause: cause,
+	}
+}
+
+func (e *generatorError) Error() string {
+	return e.kind.Error() + ": " + e.cause.Error()
+}
+
+func (e *generatorError) Is(target error) bool {
+	return target == e.kind
+}
+
+func (e *generatorError) Unwrap() error {
+	return e.cause
+}
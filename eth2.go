@@ -0,0 +1,184 @@
+package bip39gen
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto/bls12381"
+	"golang.org/x/crypto/hkdf"
+)
+
+// EthereumValidator is the Chain for Ethereum consensus layer validators,
+// deriving BLS12-381 keys as specified by EIP-2333 at EIP-2334 paths.
+//
+// PubKey and PrivKey are the validator's signing keys, and WithdrawalPubKey
+// and WithdrawalPrivKey are the keys at the parent of the signing key path,
+// i.e. m/12381/3600/{index}/0. Validators are identified by their signing
+// public key, so Address is the 0x prefixed signing public key.
+// EIP-2333 has no hardened derivation, so paths with hardened components,
+// including those of WithHardened(true), are rejected.
+var EthereumValidator Chain = ethereumValidatorChain{}
+
+type ethereumValidatorChain struct {
+	eip2333Engine
+}
+
+func (ethereumValidatorChain) Name() string {
+	return "ethereum-validator"
+}
+
+// DefaultPathTemplate returns m/12381/3600/{index}/0/0.
+func (ethereumValidatorChain) DefaultPathTemplate() PathTemplate {
+	return MustParsePathTemplate(fmt.Sprintf("m/12381/3600/%s/0/0", IndexPlaceholder))
+}
+
+func (ethereumValidatorChain) fillAddressData(key hdKey, ad *AddressData) error {
+	var (
+		signingKey = key.(blsKey)
+		pubKey     = signingKey.publicKey()
+	)
+
+	ad.Address = "0x" + common.Bytes2Hex(pubKey)
+	ad.PubKey = common.Bytes2Hex(pubKey)
+	ad.PrivKey = common.Bytes2Hex(math.PaddedBigBytes(signingKey.sk, 32))
+
+	if withdrawalKey := signingKey.parent; withdrawalKey != nil {
+		ad.WithdrawalPubKey = common.Bytes2Hex(withdrawalKey.publicKey())
+		ad.WithdrawalPrivKey = common.Bytes2Hex(math.PaddedBigBytes(withdrawalKey.sk, 32))
+	}
+
+	return nil
+}
+
+var (
+	// blsCurveOrder is the order r of the BLS12-381 curve's subgroups.
+	blsCurveOrder = hexBig("73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001")
+	// blsFieldModulus is the order p of the BLS12-381 curve's base field.
+	blsFieldModulus = hexBig("1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfffeb153ffffb9feffffffffaaab")
+)
+
+// eip2333Engine derives BLS12-381 keys as specified by EIP-2333,
+// which has no notion of hardened derivation.
+type eip2333Engine struct{}
+
+// unhardenedOnly marks EIP-2333 paths as having no hardened components,
+// as a hardened index would silently derive the key at index + 2^31.
+func (eip2333Engine) unhardenedOnly() {}
+
+func (eip2333Engine) newMasterKey(src keySource) (hdKey, error) {
+	sk, err := hkdfModR(src.seed, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return blsKey{sk: sk}, nil
+}
+
+// blsKey is a BLS12-381 private key. The signing keys of validators
+// are derived from their withdrawal keys, so blsKeys keep a reference
+// to the key they were derived from.
+type blsKey struct {
+	sk     *big.Int
+	parent *blsKey
+}
+
+func (k blsKey) derive(index uint32) (hdKey, error) {
+	lamportPK, err := parentSKToLamportPK(k.sk, index)
+	if err != nil {
+		return nil, err
+	}
+
+	sk, err := hkdfModR(lamportPK, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	parent := k
+
+	return blsKey{sk: sk, parent: &parent}, nil
+}
+
+// publicKey returns the compressed G1 point sk*G.
+func (k blsKey) publicKey() []byte {
+	g1 := bls12381.NewG1()
+
+	point := g1.Affine(g1.MulScalar(g1.New(), g1.One(), k.sk))
+	raw := g1.ToBytes(point)
+
+	var (
+		pubKey = raw[:48]
+		y      = new(big.Int).SetBytes(raw[48:])
+		half   = new(big.Int).Rsh(blsFieldModulus, 1)
+	)
+
+	// the top three bits flag the compressed encoding,
+	// the point at infinity, and whether y is the larger of its two values
+	pubKey[0] |= 0x80
+
+	if y.Cmp(half) > 0 {
+		pubKey[0] |= 0x20
+	}
+
+	return pubKey
+}
+
+// hkdfModR implements EIP-2333's HKDF_mod_r.
+func hkdfModR(ikm, keyInfo []byte) (*big.Int, error) {
+	const l = 48
+
+	var (
+		salt = []byte("BLS-SIG-KEYGEN-SALT-")
+		sk   = new(big.Int)
+	)
+
+	for sk.Sign() == 0 {
+		digest := sha256.Sum256(salt)
+		salt = digest[:]
+
+		prk := hkdf.Extract(sha256.New, append(append([]byte{}, ikm...), 0x00), salt)
+		okm := make([]byte, l)
+
+		if _, err := io.ReadFull(hkdf.Expand(sha256.New, prk, append(append([]byte{}, keyInfo...), 0x00, l)), okm); err != nil {
+			return nil, err
+		}
+
+		sk.SetBytes(okm).Mod(sk, blsCurveOrder)
+	}
+
+	return sk, nil
+}
+
+// parentSKToLamportPK implements EIP-2333's parent_SK_to_lamport_PK,
+// returning the compressed Lamport public key.
+func parentSKToLamportPK(parentSK *big.Int, index uint32) ([]byte, error) {
+	var (
+		salt   = []byte{byte(index >> 24), byte(index >> 16), byte(index >> 8), byte(index)}
+		ikm    = math.PaddedBigBytes(parentSK, 32)
+		notIKM = make([]byte, len(ikm))
+	)
+
+	for i, b := range ikm {
+		notIKM[i] = ^b
+	}
+
+	lamportPK := sha256.New()
+
+	for _, secret := range [][]byte{ikm, notIKM} {
+		lamportSK := make([]byte, 32*255)
+
+		if _, err := io.ReadFull(hkdf.New(sha256.New, secret, salt, nil), lamportSK); err != nil {
+			return nil, err
+		}
+
+		for i := 0; i < len(lamportSK); i += 32 {
+			chunk := sha256.Sum256(lamportSK[i : i+32])
+			_, _ = lamportPK.Write(chunk[:])
+		}
+	}
+
+	return lamportPK.Sum(nil), nil
+}
@@ -0,0 +1,72 @@
+package bip39gen
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestEIP2333(t *testing.T) {
+	// EIP-2333 test vectors
+	tests := []struct {
+		seed     string
+		masterSK string
+		index    uint32
+		childSK  string
+	}{
+		{
+			seed:     "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+			masterSK: "6083874454709270928345386274498605044986640685124978867557563392430687146096",
+			index:    0,
+			childSK:  "20397789859736650942317412262472558107875392172444076792671091975210932703118",
+		},
+		{
+			seed:     "3141592653589793238462643383279502884197169399375105820974944592",
+			masterSK: "29757020647961307431480504535336562678282505419141012933316116377660817309383",
+			index:    3141592653,
+			childSK:  "25457201688850691947727629385191704516744796114925897962676248250929345014287",
+		},
+	}
+
+	for _, tt := range tests {
+		master, err := eip2333Engine{}.newMasterKey(keySource{seed: common.Hex2Bytes(tt.seed)})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		child, err := master.derive(tt.index)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if want, _ := new(big.Int).SetString(tt.masterSK, 10); master.(blsKey).sk.Cmp(want) != 0 {
+			t.Errorf("got master key %s, want %s", master.(blsKey).sk, want)
+		}
+
+		if want, _ := new(big.Int).SetString(tt.childSK, 10); child.(blsKey).sk.Cmp(want) != 0 {
+			t.Errorf("got child key %s, want %s", child.(blsKey).sk, want)
+		}
+	}
+}
+
+func TestEthereumValidatorHardened(t *testing.T) {
+	tests := []struct {
+		name string
+		opt  GeneratorOpt
+	}{
+		{name: "hardened index", opt: WithHardened(true)},
+		{name: "hardened template", opt: WithPathTemplate(MustParsePathTemplate("m/12381'/3600/{index}/0/0"))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGenerator(WithChain(EthereumValidator), WithMnemonic(testMnemonic), tt.opt)
+
+			if _, err := g.GenerateAddress(); !errors.Is(err, ErrInvalidPathTemplate) {
+				t.Errorf("got error %v, want %v", err, ErrInvalidPathTemplate)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package bip39gen
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Ethereum is the Chain for Ethereum and EVM-compatible networks,
+// and is the Generator's default Chain.
+var Ethereum Chain = ethereumChain{}
+
+type ethereumChain struct {
+	bip32Engine
+}
+
+func (ethereumChain) Name() string {
+	return "ethereum"
+}
+
+func (ethereumChain) DefaultPathTemplate() PathTemplate {
+	return DefaultPathTemplate
+}
+
+func (ethereumChain) fillAddressData(key hdKey, ad *AddressData) error {
+	privKey, err := bip32PrivKey(key)
+	if err != nil {
+		return err
+	}
+
+	ecdsaKey := privKey.ToECDSA()
+
+	ad.Address = crypto.PubkeyToAddress(ecdsaKey.PublicKey).String()
+	ad.PubKey = common.Bytes2Hex(crypto.FromECDSAPub(&ecdsaKey.PublicKey)[1:])
+	ad.PrivKey = common.Bytes2Hex(crypto.FromECDSA(ecdsaKey))
+
+	return nil
+}
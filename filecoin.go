@@ -0,0 +1,87 @@
+package bip39gen
+
+import (
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"golang.org/x/crypto/blake2b"
+)
+
+// Filecoin is the Chain for Filecoin, generating mainnet f1 addresses.
+//
+// PrivKeyEncoded is the key in the hex-encoded format
+// exported and imported by lotus wallet export and import.
+var Filecoin Chain = filecoinChain{}
+
+// filecoinSecp256k1Protocol is the address protocol of f1 addresses.
+const filecoinSecp256k1Protocol byte = 1
+
+var filecoinBase32 = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+
+type filecoinChain struct {
+	bip32Engine
+}
+
+func (filecoinChain) Name() string {
+	return "filecoin"
+}
+
+// DefaultPathTemplate returns m/44'/461'/{account}'/0/{index}.
+func (filecoinChain) DefaultPathTemplate() PathTemplate {
+	return MustParsePathTemplate(fmt.Sprintf("m/44'/461'/%s'/0/%s", AccountPlaceholder, IndexPlaceholder))
+}
+
+func (filecoinChain) fillAddressData(key hdKey, ad *AddressData) error {
+	privKey, err := bip32PrivKey(key)
+	if err != nil {
+		return err
+	}
+
+	pubKey := privKey.PubKey().SerializeUncompressed()
+
+	ad.Address = filecoinAddress(blake2bSum(20, pubKey))
+	ad.PubKey = common.Bytes2Hex(pubKey)
+	ad.PrivKey = common.Bytes2Hex(privKey.Serialize())
+
+	ad.PrivKeyEncoded, err = lotusKeyExport(privKey.Serialize())
+	if err != nil {
+		return wrapErr(ErrDerivation, err)
+	}
+
+	return nil
+}
+
+// filecoinAddress returns the f1 address of the 20-byte blake2b hash
+// of an uncompressed secp256k1 public key.
+func filecoinAddress(payload []byte) string {
+	checksum := blake2bSum(4, append([]byte{filecoinSecp256k1Protocol}, payload...))
+
+	return fmt.Sprintf("f%d%s", filecoinSecp256k1Protocol, filecoinBase32.EncodeToString(append(payload, checksum...)))
+}
+
+// lotusKeyExport returns the hex encoding of lotus' JSON key info.
+func lotusKeyExport(privKey []byte) (string, error) {
+	keyInfo, err := json.Marshal(struct {
+		Type       string
+		PrivateKey string
+	}{
+		Type:       "secp256k1",
+		PrivateKey: base64.StdEncoding.EncodeToString(privKey),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return common.Bytes2Hex(keyInfo), nil
+}
+
+// blake2bSum returns the blake2b hash of data of the given size in bytes.
+func blake2bSum(size int, data []byte) []byte {
+	h, _ := blake2b.New(size, nil)
+	_, _ = h.Write(data)
+
+	return h.Sum(nil)
+}
@@ -0,0 +1,44 @@
+package bip39gen
+
+import (
+	"testing"
+)
+
+func TestFilecoinAddress(t *testing.T) {
+	// secp256k1 address vectors from go-address
+	tests := []string{
+		"f1abjxfbp274xpdqcpuaykwkfb43omjotacm2p3za",
+		"f17uoq6tp427uzv7fztkbsnn64iwotfrristwpryy",
+	}
+
+	for _, want := range tests {
+		t.Run(want, func(t *testing.T) {
+			decoded, err := filecoinBase32.DecodeString(want[2:])
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := filecoinAddress(decoded[:20]); got != want {
+				t.Errorf("got %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestLotusKeyExport(t *testing.T) {
+	privKey := make([]byte, 32)
+	for i := range privKey {
+		privKey[i] = byte(i + 1)
+	}
+
+	got, err := lotusKeyExport(privKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// hex of {"Type":"secp256k1","PrivateKey":"AQIDBAUGBwgJCgsMDQ4PEBESExQVFhcYGRobHB0eHyA="}
+	want := "7b2254797065223a22736563703235366b31222c22507269766174654b6579223a2241514944424155474277674a4367734d4451345045424553457851564668635947526f62484230654879413d227d"
+	if got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
@@ -29,32 +29,7 @@ func (a AddressDataOutput) FormatText() []byte {
 		addrData := data.(AddressDataOutput)
 
 		for _, field := range datakeys.FieldOrder {
-			var dataVal *string
-
-			switch field {
-			case datakeys.Address:
-				dataVal = addrData.Address
-			case datakeys.Name:
-				dataVal = addrData.Name
-			case datakeys.Entropy:
-				dataVal = addrData.Entropy
-			case datakeys.Mnemonic:
-				dataVal = addrData.mnemonic()
-			case datakeys.Seed:
-				dataVal = addrData.Seed
-			case datakeys.Pubkey:
-				dataVal = addrData.Pubkey
-			case datakeys.Privkey:
-				dataVal = addrData.Privkey
-			case datakeys.WalletIndex:
-				dataVal = addrData.walletIndex()
-			case datakeys.DerivationPath:
-				dataVal = addrData.DerivationPath
-			case datakeys.Hardened:
-				dataVal = addrData.hardened()
-			default:
-				continue
-			}
+			dataVal := addrData.fieldValue(field)
 
 			numTabs := 1
 
@@ -72,6 +47,59 @@ func (a AddressDataOutput) FormatText() []byte {
 	})
 }
 
+// fieldValue returns the string representation of the value
+// of the output field named field, or nil if it's unset.
+func (a AddressDataOutput) fieldValue(field string) (dataVal *string) {
+	switch field {
+	case datakeys.Address:
+		dataVal = a.Address
+	case datakeys.AddressHex:
+		dataVal = a.AddressHex
+	case datakeys.StakeAddress:
+		dataVal = a.StakeAddress
+	case datakeys.Name:
+		dataVal = a.Name
+	case datakeys.Chain:
+		dataVal = a.Chain
+	case datakeys.Network:
+		dataVal = a.Network
+	case datakeys.AddressType:
+		dataVal = a.AddressType
+	case datakeys.AddressFormat:
+		dataVal = a.AddressFormat
+	case datakeys.Entropy:
+		dataVal = a.Entropy
+	case datakeys.Mnemonic:
+		dataVal = a.mnemonic()
+	case datakeys.Seed:
+		dataVal = a.Seed
+	case datakeys.Pubkey:
+		dataVal = a.Pubkey
+	case datakeys.TweakedPubkey:
+		dataVal = a.TweakedPubkey
+	case datakeys.ExtendedPubkey:
+		dataVal = a.ExtendedPubkey
+	case datakeys.Privkey:
+		dataVal = a.Privkey
+	case datakeys.PrivkeyEncoded:
+		dataVal = a.PrivkeyEncoded
+	case datakeys.WithdrawalPubkey:
+		dataVal = a.WithdrawalPubkey
+	case datakeys.WithdrawalPrivkey:
+		dataVal = a.WithdrawalPrivkey
+	case datakeys.WalletIndex:
+		dataVal = a.walletIndex()
+	case datakeys.DerivationPath:
+		dataVal = a.DerivationPath
+	case datakeys.PathPreset:
+		dataVal = a.PathPreset
+	case datakeys.Hardened:
+		dataVal = a.hardened()
+	}
+
+	return
+}
+
 func (s AddressDataOutputSlice) FormatJSON() []byte {
 	return outformat.JSON.Marshal(s)
 }
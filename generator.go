@@ -0,0 +1,203 @@
+package bip39gen
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/tyler-smith/go-bip39"
+)
+
+// Generator generates AddressData using the options
+// it was constructed with.
+type Generator struct {
+	opts *generatorOpts
+}
+
+// NewGenerator constructs and returns a *Generator instance
+// using any passed GeneratorOpt parameters.
+func NewGenerator(opts ...GeneratorOpt) *Generator {
+	g := &Generator{
+		opts: defaultGeneratorOpts(),
+	}
+
+	for _, o := range opts {
+		o.apply(g.opts)
+	}
+
+	return g
+}
+
+// GenerateAddress returns a AddressData struct initialized
+// using random values for entropy, seed, mnemonic, and wallet index,
+// unless a mnemonic or entropy was provided to the Generator.
+func (g *Generator) GenerateAddress() (AddressData, error) {
+	_, job, err := g.drawAddress()
+	if err != nil {
+		return AddressData{}, err
+	}
+
+	return job()
+}
+
+// GenerateAddresses generates num AddressData structs using GenerateAddress,
+// guaranteeing that no address is returned more than once.
+// Addresses are generated concurrently if the Generator was
+// constructed using WithWorkers.
+func (g *Generator) GenerateAddresses(num int) ([]AddressData, error) {
+	return collect(g.Stream(context.Background(), num), num)
+}
+
+// GenerateAddressesSingleMnemonic generates a slice of AddressData structs, all of which
+// are initialized using the same entropy, seed, and mnemonic.
+// If the Generator wasn't provided a mnemonic or entropy, one is randomly generated.
+// The seed and account extended key are only computed once,
+// and every address is derived from them.
+func (g *Generator) GenerateAddressesSingleMnemonic(num int) ([]AddressData, error) {
+	return collect(g.StreamSingleMnemonic(context.Background(), num), num)
+}
+
+// addressJob derives an address from values which were already drawn
+// from the Generator's random source, and so is safe to call concurrently.
+type addressJob func() (AddressData, error)
+
+// addressKey identifies the address derived from drawn values,
+// so that duplicate addresses can be redrawn before they're derived.
+type addressKey struct {
+	entropy string
+	index   int
+}
+
+// drawAddress draws the wallet index, entropy, and name of
+// an address from the Generator's random source, in that order.
+func (g *Generator) drawAddress() (addressKey, addressJob, error) {
+	idx, err := g.randomIndex()
+	if err != nil {
+		return addressKey{}, nil, err
+	}
+
+	entropy, err := g.opts.drawEntropy()
+	if err != nil {
+		return addressKey{}, nil, err
+	}
+
+	key := addressKey{entropy: string(entropy)}
+
+	// the index doesn't affect the address of chains without paths
+	if !g.pathTemplate().isZero() {
+		key.index = idx
+	}
+
+	var name string
+
+	if g.opts.genName {
+		mnemonic, err := bip39.NewMnemonic(entropy)
+		if err != nil {
+			return addressKey{}, nil, wrapErr(ErrDerivation, err)
+		}
+
+		if name, err = genNameFromMnemonic(g.opts.randSource, mnemonic); err != nil {
+			return addressKey{}, nil, err
+		}
+	}
+
+	return key, func() (AddressData, error) {
+		node, err := g.newNode(entropy)
+		if err != nil {
+			return AddressData{}, err
+		}
+
+		return makeDerivedAddress(node, idx, name)
+	}, nil
+}
+
+// pathTemplate returns the Generator's path template,
+// or its chain's default template if it wasn't given one.
+func (g *Generator) pathTemplate() PathTemplate {
+	if g.opts.pathTemplate.isZero() {
+		return g.opts.chain.DefaultPathTemplate()
+	}
+
+	return g.opts.pathTemplate
+}
+
+func (g *Generator) newNode(entropy []byte) (*hdNode, error) {
+	if err := g.checkPathTemplate(); err != nil {
+		return nil, err
+	}
+
+	// a preset's name is only accurate if its path is used as-is
+	if g.opts.pathPreset != "" && g.opts.hardened && !g.opts.pathTemplate.IndexHardened(false) {
+		return nil, wrapErr(ErrInvalidPathTemplate, errors.Errorf(
+			"hardening the index of path preset %s would change its derivation path", g.opts.pathPreset,
+		))
+	}
+
+	node, err := newHDNode(g.opts.chain, g.pathTemplate(), g.opts.account, g.opts.hardened, g.opts.passphrase, g.opts.walletOpts(entropy)...)
+	if err != nil {
+		return nil, err
+	}
+
+	node.pathPreset = g.opts.pathPreset
+
+	return node, nil
+}
+
+// checkAddressCount returns an error if num addresses can't be generated.
+func checkAddressCount(num int) error {
+	if num < 0 {
+		return wrapErr(ErrInvalidAddressCount, errors.Errorf("can't generate %d addresses", num))
+	}
+
+	return nil
+}
+
+// checkPathTemplate returns an error if the Generator was given a path template
+// for a chain whose keys aren't derived from paths.
+func (g *Generator) checkPathTemplate() error {
+	if !g.opts.pathTemplate.isZero() && g.opts.chain.DefaultPathTemplate().isZero() {
+		return wrapErr(ErrInvalidPathTemplate, errors.Errorf(
+			"%s keys aren't derived from paths, so a path template can't be used", g.opts.chain.Name(),
+		))
+	}
+
+	return nil
+}
+
+// checkKeysPerMnemonic returns an error if generating num addresses
+// would require more than one address per mnemonic from a chain
+// whose keys aren't derived from paths, and so only has one.
+func (g *Generator) checkKeysPerMnemonic(num int, singleMnemonic bool) error {
+	if singleMnemonic && num > 1 && g.opts.chain.DefaultPathTemplate().isZero() {
+		return wrapErr(ErrDerivation, errors.Errorf(
+			"%s keys aren't derived from paths, so a mnemonic only has one address", g.opts.chain.Name(),
+		))
+	}
+
+	return nil
+}
+
+// checkIndexRangeSize returns an error if the Generator's index range
+// has fewer than num indices, and so can't be used to derive num unique
+// addresses from a single mnemonic.
+func (g *Generator) checkIndexRangeSize(num int) error {
+	if err := g.opts.validateIndexRange(); err != nil {
+		return err
+	}
+
+	if size := g.opts.maxIndex - g.opts.minIndex + 1; num > size {
+		return wrapErr(ErrInvalidIndexRange, errors.Errorf(
+			"can't generate %d unique addresses from a single mnemonic using the %d indices in [%d, %d]",
+			num, size, g.opts.minIndex, g.opts.maxIndex,
+		))
+	}
+
+	return nil
+}
+
+func (g *Generator) randomIndex() (int, error) {
+	if err := g.opts.validateIndexRange(); err != nil {
+		return 0, err
+	}
+
+	return randomInt(g.opts.randSource, g.opts.minIndex, g.opts.maxIndex)
+}
@@ -0,0 +1,248 @@
+package bip39gen
+
+import (
+	"context"
+	"errors"
+	mathrand "math/rand"
+	"testing"
+)
+
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+func TestGenerateAddressesSingleMnemonicWorkers(t *testing.T) {
+	const num = 64
+
+	want, err := NewGenerator(
+		WithMnemonic(testMnemonic),
+		WithIndexStrategy(SequentialIndex),
+	).GenerateAddressesSingleMnemonic(num)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := NewGenerator(
+		WithMnemonic(testMnemonic),
+		WithWorkers(8),
+		WithIndexStrategy(SequentialIndex),
+	).GenerateAddressesSingleMnemonic(num)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(got) != num {
+		t.Fatalf("got %d addresses, want %d", len(got), num)
+	}
+
+	for i := range want {
+		if got[i].Address != want[i].Address || got[i].WalletIndex != i {
+			t.Errorf("address %d: got %s at index %d, want %s", i, got[i].Address, got[i].WalletIndex, want[i].Address)
+		}
+	}
+}
+
+func TestGenerateAddressesRandomSourceWorkers(t *testing.T) {
+	const num = 40
+
+	generate := func(workers int) []AddressData {
+		addrs, err := NewGenerator(
+			WithRandomSource(mathrand.New(mathrand.NewSource(1))),
+			WithWorkers(workers),
+			WithGenName(true),
+		).GenerateAddresses(num)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return addrs
+	}
+
+	want := generate(1)
+
+	for _, workers := range []int{2, 8} {
+		got := generate(workers)
+
+		for i := range want {
+			if got[i].Address != want[i].Address || got[i].WalletIndex != want[i].WalletIndex || got[i].Name != want[i].Name {
+				t.Errorf("workers %d, address %d: got %+v, want %+v", workers, i, got[i], want[i])
+			}
+		}
+	}
+}
+
+func TestGenerateAddressesRedrawWorkers(t *testing.T) {
+	const num = 40
+
+	generate := func(workers int) []AddressData {
+		addrs, err := NewGenerator(
+			WithMnemonic(testMnemonic),
+			WithRandomSource(mathrand.New(mathrand.NewSource(1))),
+			WithIndexRange(0, 49),
+			WithWorkers(workers),
+		).GenerateAddresses(num)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return addrs
+	}
+
+	want := generate(1)
+
+	for _, workers := range []int{2, 8} {
+		got := generate(workers)
+		seen := make(map[string]bool)
+
+		for i := range want {
+			if got[i].Address != want[i].Address || got[i].WalletIndex != want[i].WalletIndex {
+				t.Errorf("workers %d, address %d: got index %d, want %d", workers, i, got[i].WalletIndex, want[i].WalletIndex)
+			}
+
+			if seen[got[i].Address] {
+				t.Errorf("workers %d: address %s generated more than once", workers, got[i].Address)
+			}
+
+			seen[got[i].Address] = true
+		}
+	}
+}
+
+func TestGenerateAddressesIndexRangeSize(t *testing.T) {
+	tests := []struct {
+		name    string
+		num     int
+		wantErr error
+	}{
+		{name: "fits", num: 3},
+		{name: "too many", num: 5, wantErr: ErrInvalidIndexRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			addrs, err := NewGenerator(
+				WithMnemonic(testMnemonic),
+				WithIndexRange(1, 3),
+			).GenerateAddresses(tt.num)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr == nil && len(addrs) != tt.num {
+				t.Fatalf("got %d addresses, want %d", len(addrs), tt.num)
+			}
+		})
+	}
+}
+
+func TestGenerateAddressesSingleMnemonicIndexRangeSize(t *testing.T) {
+	tests := []struct {
+		name    string
+		num     int
+		wantErr error
+	}{
+		{name: "fits", num: 5},
+		{name: "too many", num: 10, wantErr: ErrInvalidIndexRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			addrs, err := NewGenerator(
+				WithMnemonic(testMnemonic),
+				WithIndexRange(0, 4),
+			).GenerateAddressesSingleMnemonic(tt.num)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			seen := make(map[string]bool)
+
+			for _, addr := range addrs {
+				if seen[addr.Address] {
+					t.Errorf("address %s generated more than once", addr.Address)
+				}
+
+				seen[addr.Address] = true
+			}
+
+			if len(seen) != tt.num {
+				t.Fatalf("got %d unique addresses, want %d", len(seen), tt.num)
+			}
+		})
+	}
+}
+
+func TestGenerateAddressesNegativeCount(t *testing.T) {
+	g := NewGenerator(WithMnemonic(testMnemonic))
+
+	if _, err := g.GenerateAddresses(-1); !errors.Is(err, ErrInvalidAddressCount) {
+		t.Errorf("GenerateAddresses: got error %v, want %v", err, ErrInvalidAddressCount)
+	}
+
+	if _, err := g.GenerateAddressesSingleMnemonic(-1); !errors.Is(err, ErrInvalidAddressCount) {
+		t.Errorf("GenerateAddressesSingleMnemonic: got error %v, want %v", err, ErrInvalidAddressCount)
+	}
+}
+
+func TestStreamCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	results := NewGenerator(WithMnemonic(testMnemonic), WithIndexRange(0, 1)).Stream(ctx, 2)
+
+	cancel()
+
+	for range results {
+	}
+}
+
+func BenchmarkGenerateAddressesSingleMnemonic(b *testing.B) {
+	const num = 32
+
+	g := NewGenerator(WithMnemonic(testMnemonic), WithIndexStrategy(SequentialIndex))
+
+	b.Run("cached node", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			if _, err := g.GenerateAddressesSingleMnemonic(num); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("node per address", func(b *testing.B) {
+		entropy, err := g.opts.drawEntropy()
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		for n := 0; n < b.N; n++ {
+			for i := 0; i < num; i++ {
+				node, err := g.newNode(entropy)
+				if err != nil {
+					b.Fatal(err)
+				}
+
+				if _, err = node.derive(i); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+}
+
+// deriveTestAddress returns the address of mnemonic at wallet index idx
+// of chain's default path template.
+func deriveTestAddress(t *testing.T, chain Chain, mnemonic string, idx int) AddressData {
+	t.Helper()
+
+	addr, err := NewGenerator(
+		WithChain(chain),
+		WithMnemonic(mnemonic),
+		WithIndexRange(idx, idx),
+	).GenerateAddress()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return addr
+}
@@ -0,0 +1,247 @@
+package bip39gen
+
+import (
+	"crypto/rand"
+	"io"
+	"runtime"
+
+	"github.com/btcsuite/btcd/btcutil/hdkeychain"
+	"github.com/jalavosus/hdwallet-go"
+	"github.com/pkg/errors"
+	"github.com/tyler-smith/go-bip39"
+)
+
+// IndexStrategy determines how a Generator picks
+// the derivation index of the addresses it generates.
+type IndexStrategy uint
+
+const (
+	// RandomIndex derives every address at a randomly chosen index.
+	RandomIndex IndexStrategy = iota
+	// SequentialIndex derives addresses generated from a single mnemonic
+	// at sequential indices, starting at 0.
+	SequentialIndex
+)
+
+const (
+	defaultMinIndex int = 1
+	defaultMaxIndex int = 1000
+)
+
+type generatorOpts struct {
+	passphrase    string
+	mnemonic      string
+	mnemonicLen   int
+	entropy       []byte
+	hardened      bool
+	indexStrategy IndexStrategy
+	minIndex      int
+	maxIndex      int
+	genName       bool
+	randSource    io.Reader
+	workers       int
+	chain         Chain
+	pathTemplate  PathTemplate
+	pathPreset    string
+	account       uint32
+}
+
+type funcGeneratorOpt struct {
+	f func(*generatorOpts)
+}
+
+func newFuncGeneratorOpt(f func(*generatorOpts)) *funcGeneratorOpt {
+	return &funcGeneratorOpt{f}
+}
+
+func (fo *funcGeneratorOpt) apply(opts *generatorOpts) {
+	fo.f(opts)
+}
+
+// GeneratorOpt configures a Generator. See NewGenerator.
+type GeneratorOpt interface {
+	apply(*generatorOpts)
+}
+
+// WithPassphrase sets the passphrase used for BIP39 seed generation.
+func WithPassphrase(passphrase string) GeneratorOpt {
+	return newFuncGeneratorOpt(func(opts *generatorOpts) {
+		opts.passphrase = passphrase
+	})
+}
+
+// WithMnemonic makes the Generator derive every address from the provided
+// mnemonic instead of randomly generating one.
+func WithMnemonic(mnemonic string) GeneratorOpt {
+	return newFuncGeneratorOpt(func(opts *generatorOpts) {
+		opts.mnemonic = mnemonic
+	})
+}
+
+// WithMnemonicLength sets the length of randomly generated mnemonics.
+// Allowed values are 12, 15, 18, 21, and 24.
+func WithMnemonicLength(length int) GeneratorOpt {
+	return newFuncGeneratorOpt(func(opts *generatorOpts) {
+		opts.mnemonicLen = length
+	})
+}
+
+// WithEntropy makes the Generator derive every address from the provided
+// BIP39 entropy instead of randomly generating it.
+func WithEntropy(entropy []byte) GeneratorOpt {
+	return newFuncGeneratorOpt(func(opts *generatorOpts) {
+		opts.entropy = entropy
+	})
+}
+
+// WithHardened makes the Generator use hardened wallet indices,
+// regardless of whether the path template marks the index as hardened.
+func WithHardened(hardened bool) GeneratorOpt {
+	return newFuncGeneratorOpt(func(opts *generatorOpts) {
+		opts.hardened = hardened
+	})
+}
+
+// WithIndexStrategy sets how the Generator picks derivation indices.
+func WithIndexStrategy(strategy IndexStrategy) GeneratorOpt {
+	return newFuncGeneratorOpt(func(opts *generatorOpts) {
+		opts.indexStrategy = strategy
+	})
+}
+
+// WithIndexRange sets the closed interval [min, max] from which
+// random derivation indices are picked.
+// Both bounds must be non-hardened indices, i.e. less than hdkeychain.HardenedKeyStart;
+// use WithHardened to derive hardened addresses.
+func WithIndexRange(min, max int) GeneratorOpt {
+	return newFuncGeneratorOpt(func(opts *generatorOpts) {
+		opts.minIndex = min
+		opts.maxIndex = max
+	})
+}
+
+// WithGenName makes the Generator generate a sort of "name"
+// for addresses from their mnemonic.
+func WithGenName(genName bool) GeneratorOpt {
+	return newFuncGeneratorOpt(func(opts *generatorOpts) {
+		opts.genName = genName
+	})
+}
+
+// WithRandomSource sets the randomness source used for generating entropy,
+// picking derivation indices, and generating names.
+// The default source is crypto/rand.Reader.
+// r is only ever read from one goroutine at a time, in address order,
+// so a deterministic r produces the same addresses regardless of the
+// number of workers.
+func WithRandomSource(r io.Reader) GeneratorOpt {
+	return newFuncGeneratorOpt(func(opts *generatorOpts) {
+		opts.randSource = r
+	})
+}
+
+// WithWorkers sets the number of goroutines used to generate
+// multiple addresses concurrently.
+// A value less than 1 uses one worker per CPU.
+// Output order and uniqueness guarantees are the same
+// regardless of the number of workers.
+func WithWorkers(workers int) GeneratorOpt {
+	return newFuncGeneratorOpt(func(opts *generatorOpts) {
+		if workers < 1 {
+			workers = runtime.NumCPU()
+		}
+
+		opts.workers = workers
+	})
+}
+
+// WithChain sets the chain addresses are generated for.
+// The default chain is Ethereum.
+func WithChain(chain Chain) GeneratorOpt {
+	return newFuncGeneratorOpt(func(opts *generatorOpts) {
+		opts.chain = chain
+	})
+}
+
+// WithPathTemplate sets the derivation path template addresses are derived at.
+// The default template is the chain's DefaultPathTemplate.
+// Generating addresses returns ErrInvalidPathTemplate if the chain's
+// keys aren't derived from paths, such as Algorand's.
+func WithPathTemplate(template PathTemplate) GeneratorOpt {
+	return newFuncGeneratorOpt(func(opts *generatorOpts) {
+		opts.pathTemplate = template
+		opts.pathPreset = ""
+	})
+}
+
+// WithPathPreset derives addresses using the preset's template,
+// and records the preset's name in generated AddressData.
+// Generating addresses returns ErrInvalidPathTemplate if WithHardened is used
+// with a preset whose index isn't already hardened.
+func WithPathPreset(preset PathPreset) GeneratorOpt {
+	return newFuncGeneratorOpt(func(opts *generatorOpts) {
+		opts.pathTemplate = preset.Template
+		opts.pathPreset = preset.Name
+	})
+}
+
+// WithAccount sets the value the path template's AccountPlaceholder
+// component resolves to. The default account is 0.
+// Generating addresses returns ErrInvalidPathTemplate if account is non-zero
+// and the path template has no AccountPlaceholder component.
+func WithAccount(account uint32) GeneratorOpt {
+	return newFuncGeneratorOpt(func(opts *generatorOpts) {
+		opts.account = account
+	})
+}
+
+func defaultGeneratorOpts() *generatorOpts {
+	return &generatorOpts{
+		mnemonicLen:   24,
+		indexStrategy: RandomIndex,
+		minIndex:      defaultMinIndex,
+		maxIndex:      defaultMaxIndex,
+		randSource:    rand.Reader,
+		workers:       1,
+		chain:         Ethereum,
+	}
+}
+
+func (o *generatorOpts) validateIndexRange() error {
+	if o.minIndex < 0 || o.maxIndex < o.minIndex || o.maxIndex >= hdkeychain.HardenedKeyStart {
+		return wrapErr(ErrInvalidIndexRange, errors.Errorf(
+			"got [%d, %d]; indices must be in [0, %d)",
+			o.minIndex, o.maxIndex, hdkeychain.HardenedKeyStart,
+		))
+	}
+
+	return nil
+}
+
+// drawEntropy returns the entropy of the Generator's mnemonic or entropy,
+// or draws new entropy from its random source if it was given neither.
+func (o *generatorOpts) drawEntropy() ([]byte, error) {
+	switch {
+	case o.entropy != nil:
+		return o.entropy, nil
+	case o.mnemonic != "":
+		entropy, err := bip39.EntropyFromMnemonic(o.mnemonic)
+		if err != nil {
+			return nil, wrapErr(ErrDerivation, err)
+		}
+
+		return entropy, nil
+	default:
+		_, entropy, err := GenerateMnemonicAndEntropyFrom(o.randSource, o.mnemonicLen)
+		return entropy, err
+	}
+}
+
+func (o *generatorOpts) walletOpts(entropy []byte) []hdwallet.NewWalletOpt {
+	// hdwallet regenerates the mnemonic from the entropy,
+	// which keeps the two consistent with each other.
+	return []hdwallet.NewWalletOpt{
+		hdwallet.WithPassphrase(o.passphrase),
+		hdwallet.WithEntropy(entropy),
+	}
+}
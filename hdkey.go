@@ -0,0 +1,225 @@
+package bip39gen
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+
+	"filippo.io/edwards25519"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil/hdkeychain"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// hdKey is a node of a hierarchical deterministic key tree.
+// Each Chain derives keys of a single kind, and its fillAddressData
+// may assume it's only passed keys of that kind.
+type hdKey interface {
+	derive(index uint32) (hdKey, error)
+}
+
+// keySource contains the BIP39 data a Chain's master key is generated from.
+// Most chains only use the seed.
+type keySource struct {
+	seed       []byte
+	entropy    []byte
+	passphrase string
+}
+
+// unhardenedEngine is implemented by engines which have no notion of
+// hardened derivation, so paths with hardened components are rejected.
+type unhardenedEngine interface {
+	unhardenedOnly()
+}
+
+// bip32Engine derives secp256k1 keys as specified by BIP32.
+type bip32Engine struct{}
+
+func (bip32Engine) newMasterKey(src keySource) (hdKey, error) {
+	key, err := hdkeychain.NewMaster(src.seed, &chaincfg.MainNetParams)
+	if err != nil {
+		return nil, err
+	}
+
+	return bip32Key{key}, nil
+}
+
+type bip32Key struct {
+	*hdkeychain.ExtendedKey
+}
+
+func (k bip32Key) derive(index uint32) (hdKey, error) {
+	child, err := k.Derive(index)
+	if err != nil {
+		return nil, err
+	}
+
+	return bip32Key{child}, nil
+}
+
+// bip32PrivKey returns the private key of key, which must be a bip32Key.
+func bip32PrivKey(key hdKey) (*btcec.PrivateKey, error) {
+	privKey, err := key.(bip32Key).ECPrivKey()
+	if err != nil {
+		return nil, wrapErr(ErrDerivation, err)
+	}
+
+	return privKey, nil
+}
+
+// slip10Engine derives ed25519 keys as specified by SLIP-0010,
+// which only supports hardened derivation.
+type slip10Engine struct{}
+
+func (slip10Engine) newMasterKey(src keySource) (hdKey, error) {
+	return newSLIP10Key([]byte("ed25519 seed"), src.seed), nil
+}
+
+type slip10Key struct {
+	key       []byte
+	chainCode []byte
+}
+
+func newSLIP10Key(hmacKey, data []byte) slip10Key {
+	mac := hmac.New(sha512.New, hmacKey)
+	_, _ = mac.Write(data)
+	sum := mac.Sum(nil)
+
+	return slip10Key{key: sum[:32], chainCode: sum[32:]}
+}
+
+func (k slip10Key) derive(index uint32) (hdKey, error) {
+	if index < hdkeychain.HardenedKeyStart {
+		return nil, errors.Errorf("ed25519 keys only support hardened derivation, got index %d", index)
+	}
+
+	data := make([]byte, 1+len(k.key)+4)
+	copy(data[1:], k.key)
+	binary.BigEndian.PutUint32(data[1+len(k.key):], index)
+
+	return newSLIP10Key(k.chainCode, data), nil
+}
+
+// ed25519PrivKey returns the private key of key, which must be a slip10Key.
+func ed25519PrivKey(key hdKey) ed25519.PrivateKey {
+	return ed25519.NewKeyFromSeed(key.(slip10Key).key)
+}
+
+// icarusEngine derives Cardano's ed25519-bip32 keys, generating
+// the master key from the BIP39 entropy as Icarus and Yoroi do.
+type icarusEngine struct{}
+
+func (icarusEngine) newMasterKey(src keySource) (hdKey, error) {
+	k := pbkdf2.Key([]byte(src.passphrase), src.entropy, 4096, 96, sha512.New)
+
+	// clamp the key as ed25519 does, additionally clearing the third
+	// highest bit to prevent derivation from overflowing kL
+	k[0] &= 0b1111_1000
+	k[31] &= 0b0001_1111
+	k[31] |= 0b0100_0000
+
+	return icarusKey{kL: k[:32], kR: k[32:64], chainCode: k[64:]}, nil
+}
+
+// icarusKey is an ed25519-bip32 extended private key.
+// Cardano derives stake keys from the account key of payment keys,
+// so icarusKeys keep a reference to the key they were derived from.
+type icarusKey struct {
+	kL        []byte
+	kR        []byte
+	chainCode []byte
+	parent    *icarusKey
+}
+
+func (k icarusKey) derive(index uint32) (hdKey, error) {
+	ser := make([]byte, 4)
+	binary.LittleEndian.PutUint32(ser, index)
+
+	var (
+		zMac  = hmac.New(sha512.New, k.chainCode)
+		ccMac = hmac.New(sha512.New, k.chainCode)
+	)
+
+	if index >= hdkeychain.HardenedKeyStart {
+		_, _ = zMac.Write([]byte{0x00})
+		_, _ = zMac.Write(k.kL)
+		_, _ = zMac.Write(k.kR)
+		_, _ = ccMac.Write([]byte{0x01})
+		_, _ = ccMac.Write(k.kL)
+		_, _ = ccMac.Write(k.kR)
+	} else {
+		pubKey := k.publicKey()
+
+		_, _ = zMac.Write([]byte{0x02})
+		_, _ = zMac.Write(pubKey)
+		_, _ = ccMac.Write([]byte{0x03})
+		_, _ = ccMac.Write(pubKey)
+	}
+
+	_, _ = zMac.Write(ser)
+	_, _ = ccMac.Write(ser)
+
+	var (
+		z      = zMac.Sum(nil)
+		kL     = make([]byte, 32)
+		kR     = make([]byte, 32)
+		carry  int
+		parent = k
+	)
+
+	// kL = kL + 8*zL, using the lower 28 bytes of zL
+	for i := 0; i < 32; i++ {
+		r := int(k.kL[i]) + carry
+		if i < 28 {
+			r += int(z[i]) << 3
+		}
+
+		kL[i] = byte(r)
+		carry = r >> 8
+	}
+
+	// kR = kR + zR mod 2^256
+	carry = 0
+
+	for i := 0; i < 32; i++ {
+		r := int(k.kR[i]) + int(z[32+i]) + carry
+		kR[i] = byte(r)
+		carry = r >> 8
+	}
+
+	return icarusKey{
+		kL:        kL,
+		kR:        kR,
+		chainCode: ccMac.Sum(nil)[32:],
+		parent:    &parent,
+	}, nil
+}
+
+// publicKey returns the ed25519 point kL*B.
+// Unlike ed25519 seeds, kL is used as a scalar as is.
+func (k icarusKey) publicKey() []byte {
+	s, err := edwards25519.NewScalar().SetUniformBytes(append(append([]byte{}, k.kL...), make([]byte, 32)...))
+	if err != nil {
+		// SetUniformBytes only fails if not passed 64 bytes
+		panic(err)
+	}
+
+	return new(edwards25519.Point).ScalarBaseMult(s).Bytes()
+}
+
+// entropyEngine uses the BIP39 entropy as the key itself,
+// for chains whose keys aren't derived from paths.
+type entropyEngine struct{}
+
+func (entropyEngine) newMasterKey(src keySource) (hdKey, error) {
+	return entropyKey(src.entropy), nil
+}
+
+type entropyKey []byte
+
+func (entropyKey) derive(uint32) (hdKey, error) {
+	return nil, errors.New("keys of this chain aren't derived from paths")
+}
@@ -0,0 +1,77 @@
+package bip39gen
+
+import (
+	"crypto/ed25519"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil/hdkeychain"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestSLIP10Ed25519(t *testing.T) {
+	// test vector 1 for ed25519 from SLIP-0010
+	tests := []struct {
+		path      string
+		chainCode string
+		privKey   string
+		pubKey    string
+	}{
+		{
+			path:      "m",
+			chainCode: "90046a93de5380a72b5e45010748567d5ea02bbf6522f979e05c0d8d8ca9fffb",
+			privKey:   "2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7",
+			pubKey:    "00a4b2856bfec510abab89753fac1ac0e1112364e7d250545963f135f2a33188ed",
+		},
+		{
+			path:      "m/0'",
+			chainCode: "8b59aa11380b624e81507a27fedda59fea6d0b779a778918a2fd3590e16e9c69",
+			privKey:   "68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3",
+			pubKey:    "008c8a13df77a28f3445213a0f432fde644acaa215fc72dcdf300d5efaa85d350c",
+		},
+		{
+			path:      "m/0'/1'",
+			chainCode: "a320425f77d1b5c2505a6b1b27382b37368ee640e3557c315416801243552f14",
+			privKey:   "b1d0bad404bf35da785a64ca1ac54b2617211d2777696fbffaf208f746ae84f2",
+			pubKey:    "001932a5270f335bed617d5b935c80aedb1a35bd9fc1e31acafd5372c30f5c1187",
+		},
+		{
+			path:      "m/0'/1'/2'",
+			chainCode: "2e69929e00b5ab250f49c3fb1c12f252de4fed2c1db88387094a0f8c4c9ccd6c",
+			privKey:   "92a5b23c0b8a99e37d07df3fb9966917f5d06e02ddbd909c7e184371463e9fc9",
+		},
+	}
+
+	key, err := slip10Engine{}.newMasterKey(keySource{seed: common.FromHex("000102030405060708090a0b0c0d0e0f")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, tt := range tests {
+		if i > 0 {
+			if key, err = key.derive(hdkeychain.HardenedKeyStart + uint32(i-1)); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		var (
+			k      = key.(slip10Key)
+			pubKey = "00" + common.Bytes2Hex(ed25519PrivKey(key).Public().(ed25519.PublicKey))
+		)
+
+		if got := common.Bytes2Hex(k.chainCode); got != tt.chainCode {
+			t.Errorf("%s: got chain code %s, want %s", tt.path, got, tt.chainCode)
+		}
+
+		if got := common.Bytes2Hex(k.key); got != tt.privKey {
+			t.Errorf("%s: got private key %s, want %s", tt.path, got, tt.privKey)
+		}
+
+		if tt.pubKey != "" && pubKey != tt.pubKey {
+			t.Errorf("%s: got public key %s, want %s", tt.path, pubKey, tt.pubKey)
+		}
+	}
+
+	if _, err = key.derive(0); err == nil {
+		t.Error("derived a non-hardened ed25519 key")
+	}
+}
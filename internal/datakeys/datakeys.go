@@ -1,29 +1,53 @@
 package datakeys
 
 const (
-	Address        string = "address"
-	Name           string = "name"
-	Pubkey         string = "pubkey"
-	Privkey        string = "privkey"
-	Entropy        string = "entropy"
-	Seed           string = "seed"
-	Mnemonic       string = "mnemonic"
-	WalletIndex    string = "wallet_index"
-	DerivationPath string = "derivation_path"
-	Hardened       string = "hardened"
+	Address           string = "address"
+	AddressHex        string = "address_hex"
+	StakeAddress      string = "stake_address"
+	Name              string = "name"
+	Chain             string = "chain"
+	Network           string = "network"
+	AddressType       string = "address_type"
+	AddressFormat     string = "address_format"
+	Pubkey            string = "pubkey"
+	TweakedPubkey     string = "tweaked_pubkey"
+	ExtendedPubkey    string = "extended_pubkey"
+	Privkey           string = "privkey"
+	PrivkeyEncoded    string = "privkey_encoded"
+	WithdrawalPubkey  string = "withdrawal_pubkey"
+	WithdrawalPrivkey string = "withdrawal_privkey"
+	Entropy           string = "entropy"
+	Seed              string = "seed"
+	Mnemonic          string = "mnemonic"
+	WalletIndex       string = "wallet_index"
+	DerivationPath    string = "derivation_path"
+	PathPreset        string = "path_preset"
+	Hardened          string = "hardened"
 )
 
 // FieldOrder manually sets field order for formatted output
 // as it's otherwise entirely random
 var FieldOrder = []string{
 	Address,
+	AddressHex,
+	StakeAddress,
 	Name,
+	Chain,
+	Network,
+	AddressType,
+	AddressFormat,
 	Pubkey,
+	TweakedPubkey,
+	ExtendedPubkey,
 	Privkey,
+	PrivkeyEncoded,
+	WithdrawalPubkey,
+	WithdrawalPrivkey,
 	Mnemonic,
 	Seed,
 	Entropy,
 	WalletIndex,
 	DerivationPath,
+	PathPreset,
 	Hardened,
 }
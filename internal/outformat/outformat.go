@@ -18,10 +18,12 @@ type (
 )
 
 const (
-	JSON OutFormat = iota // json
-	YAML                  // yaml
-	TOML                  // toml
-	Text                  // text
+	JSON   OutFormat = iota // json
+	YAML                    // yaml
+	TOML                    // toml
+	Text                    // text
+	NDJSON                  // ndjson
+	CSV                     // csv
 )
 
 func FromString(s string) (f OutFormat) {
@@ -34,6 +36,10 @@ func FromString(s string) (f OutFormat) {
 		f = TOML
 	case Text.String():
 		f = Text
+	case NDJSON.String():
+		f = NDJSON
+	case CSV.String():
+		f = CSV
 	default:
 		f = YAML
 	}
@@ -47,6 +53,8 @@ func (f OutFormat) Marshal(data any, rest ...any) (marshaled []byte) {
 	switch f {
 	case JSON:
 		marshaled, marshalErr = json.MarshalIndent(data, "", "  ")
+	case NDJSON:
+		marshaled, marshalErr = json.Marshal(data)
 	case YAML:
 		marshaled, marshalErr = yaml.Marshal(data)
 	case TOML:
@@ -63,6 +71,17 @@ func (f OutFormat) Marshal(data any, rest ...any) (marshaled []byte) {
 	return
 }
 
+// Streamable returns whether data in format f can be written
+// one record at a time, rather than marshaled all at once.
+func (f OutFormat) Streamable() bool {
+	switch f {
+	case Text, NDJSON, CSV:
+		return true
+	default:
+		return false
+	}
+}
+
 func FormatText(data any, format FormatTextFn) []byte {
 	buf := new(bytes.Buffer)
 
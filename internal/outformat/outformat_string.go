@@ -12,11 +12,13 @@ func _() {
 	_ = x[YAML-1]
 	_ = x[TOML-2]
 	_ = x[Text-3]
+	_ = x[NDJSON-4]
+	_ = x[CSV-5]
 }
 
-const _OutFormat_name = "jsonyamltomltext"
+const _OutFormat_name = "jsonyamltomltextndjsoncsv"
 
-var _OutFormat_index = [...]uint8{0, 4, 8, 12, 16}
+var _OutFormat_index = [...]uint8{0, 4, 8, 12, 16, 22, 25}
 
 func (i OutFormat) String() string {
 	if i >= OutFormat(len(_OutFormat_index)-1) {
@@ -6,6 +6,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/tyler-smith/go-bip39"
 
+	"github.com/jalavosus/bip39gen"
 	"github.com/jalavosus/bip39gen/internal/outformat"
 )
 
@@ -21,31 +22,13 @@ type CLIParams struct {
 	GenName           bool
 	Hardened          bool
 	SequentialIndex   bool
-}
-
-func (p CLIParams) GeneratorParams() *GeneratorParams {
-	return &GeneratorParams{
-		OutFormat:       p.OutFormat,
-		Excludes:        p.ExcludeFromOutput,
-		Passphrase:      p.Passphrase,
-		Mnemonic:        p.Mnemonic,
-		MnemonicLen:     p.MnemonicLength,
-		GenName:         p.GenName,
-		Hardened:        p.Hardened,
-		SequentialIndex: p.SequentialIndex,
-	}
-}
-
-type GeneratorParams struct {
-	OutFormat       outformat.OutFormat
-	Excludes        map[string]bool
-	Passphrase      string
-	Mnemonic        string
-	MnemonicLen     int
-	Entropy         []byte
-	GenName         bool
-	Hardened        bool
-	SequentialIndex bool
+	MinIndex          int
+	MaxIndex          int
+	Workers           int
+	Chain             bip39gen.Chain
+	PathTemplate      *bip39gen.PathTemplate
+	PathPreset        *bip39gen.PathPreset
+	Account           uint32
 }
 
 func ValidateMnemonic(mnemonic string) (mnemonicErr error) {
@@ -0,0 +1,92 @@
+package bip39gen
+
+import (
+	"crypto/ed25519"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil/bech32"
+	"github.com/ethereum/go-ethereum/common"
+	"golang.org/x/crypto/sha3"
+)
+
+// Aptos is the Chain for Aptos, deriving ed25519 keys using SLIP-0010.
+//
+// Addresses are the SHA3-256 hash of the public key followed by the
+// Ed25519 authentication scheme byte, and PrivKeyEncoded is the
+// AIP-80 encoded private key, e.g. ed25519-priv-0x...
+var Aptos Chain = aptosChain{}
+
+// Sui is the Chain for Sui, deriving ed25519 keys using SLIP-0010.
+//
+// Addresses are the BLAKE2b-256 hash of the Ed25519 signature scheme flag
+// followed by the public key, and PrivKeyEncoded is the bech32
+// encoded private key imported by Sui wallets, e.g. suiprivkey1...
+var Sui Chain = suiChain{}
+
+const (
+	// aptosEd25519Scheme is the authentication key scheme of single ed25519 keys.
+	aptosEd25519Scheme byte = 0x00
+	// suiEd25519Flag is the signature scheme flag of ed25519 keys.
+	suiEd25519Flag byte = 0x00
+)
+
+type aptosChain struct {
+	slip10Engine
+}
+
+func (aptosChain) Name() string {
+	return "aptos"
+}
+
+// DefaultPathTemplate returns m/44'/637'/{index}'/0'/0'.
+func (aptosChain) DefaultPathTemplate() PathTemplate {
+	return MustParsePathTemplate(fmt.Sprintf("m/44'/637'/%s'/0'/0'", IndexPlaceholder))
+}
+
+func (aptosChain) fillAddressData(key hdKey, ad *AddressData) error {
+	var (
+		privKey = ed25519PrivKey(key)
+		pubKey  = privKey.Public().(ed25519.PublicKey)
+	)
+
+	authKey := sha3.Sum256(append(append([]byte{}, pubKey...), aptosEd25519Scheme))
+
+	ad.Address = "0x" + common.Bytes2Hex(authKey[:])
+	ad.PubKey = common.Bytes2Hex(pubKey)
+	ad.PrivKey = common.Bytes2Hex(privKey.Seed())
+	ad.PrivKeyEncoded = "ed25519-priv-0x" + common.Bytes2Hex(privKey.Seed())
+
+	return nil
+}
+
+type suiChain struct {
+	slip10Engine
+}
+
+func (suiChain) Name() string {
+	return "sui"
+}
+
+// DefaultPathTemplate returns m/44'/784'/{index}'/0'/0'.
+func (suiChain) DefaultPathTemplate() PathTemplate {
+	return MustParsePathTemplate(fmt.Sprintf("m/44'/784'/%s'/0'/0'", IndexPlaceholder))
+}
+
+func (suiChain) fillAddressData(key hdKey, ad *AddressData) error {
+	var (
+		privKey = ed25519PrivKey(key)
+		pubKey  = privKey.Public().(ed25519.PublicKey)
+		err     error
+	)
+
+	ad.Address = "0x" + common.Bytes2Hex(blake2bSum(32, append([]byte{suiEd25519Flag}, pubKey...)))
+	ad.PubKey = common.Bytes2Hex(pubKey)
+	ad.PrivKey = common.Bytes2Hex(privKey.Seed())
+
+	ad.PrivKeyEncoded, err = bech32.EncodeFromBase256("suiprivkey", append([]byte{suiEd25519Flag}, privKey.Seed()...))
+	if err != nil {
+		return wrapErr(ErrDerivation, err)
+	}
+
+	return nil
+}
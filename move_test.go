@@ -0,0 +1,51 @@
+package bip39gen
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil/bech32"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestAptos(t *testing.T) {
+	// test vector from the Aptos TypeScript SDK
+	addr := deriveTestAddress(t, Aptos, "shoot island position soft burden budget tooth cruel issue economy destroy above", 0)
+
+	if want := "m/44'/637'/0'/0'/0'"; addr.DerivationPath != want {
+		t.Errorf("got path %s, want %s", addr.DerivationPath, want)
+	}
+
+	if want := "0x07968dab936c1bad187c60ce4082f307d030d780e91e694ae03aef16aba73f30"; addr.Address != want {
+		t.Errorf("got address %s, want %s", addr.Address, want)
+	}
+
+	if want := "5d996aa76b3212142792d9130796cd2e11e3c445a93118c08414df4f66bc60ec"; addr.PrivKey != want {
+		t.Errorf("got private key %s, want %s", addr.PrivKey, want)
+	}
+
+	if want := "ed25519-priv-0x" + addr.PrivKey; addr.PrivKeyEncoded != want {
+		t.Errorf("got encoded private key %s, want %s", addr.PrivKeyEncoded, want)
+	}
+}
+
+func TestSui(t *testing.T) {
+	// test vector from the Sui TypeScript SDK
+	addr := deriveTestAddress(t, Sui, "film crazy soon outside stand loop subway crumble thrive popular green nuclear struggle pistol arm wife phrase warfare march wheat nephew ask sunny firm", 0)
+
+	if want := "m/44'/784'/0'/0'/0'"; addr.DerivationPath != want {
+		t.Errorf("got path %s, want %s", addr.DerivationPath, want)
+	}
+
+	if want := "0xa2d14fad60c56049ecf75246a481934691214ce413e6a8ae2fe6834c173a6133"; addr.Address != want {
+		t.Errorf("got address %s, want %s", addr.Address, want)
+	}
+
+	hrp, data, err := bech32.DecodeToBase256(addr.PrivKeyEncoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := append([]byte{suiEd25519Flag}, common.FromHex(addr.PrivKey)...); hrp != "suiprivkey" || common.Bytes2Hex(data) != common.Bytes2Hex(want) {
+		t.Errorf("got %s key %x, want suiprivkey key %x", hrp, data, want)
+	}
+}
@@ -0,0 +1,51 @@
+package bip39gen
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil/bech32"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Nostr is the Chain for Nostr, deriving keys as specified by NIP-06.
+//
+// Addresses are NIP-19 npub encoded x-only public keys, and PrivKeyEncoded
+// is the nsec encoded private key. PubKey and PrivKey are their hex forms.
+var Nostr Chain = nostrChain{}
+
+type nostrChain struct {
+	bip32Engine
+}
+
+func (nostrChain) Name() string {
+	return "nostr"
+}
+
+// DefaultPathTemplate returns m/44'/1237'/{index}'/0/0,
+// where each wallet index is a NIP-06 account.
+func (nostrChain) DefaultPathTemplate() PathTemplate {
+	return MustParsePathTemplate(fmt.Sprintf("m/44'/1237'/%s'/0/0", IndexPlaceholder))
+}
+
+func (nostrChain) fillAddressData(key hdKey, ad *AddressData) error {
+	privKey, err := bip32PrivKey(key)
+	if err != nil {
+		return err
+	}
+
+	pubKey := schnorr.SerializePubKey(privKey.PubKey())
+
+	if ad.Address, err = bech32.EncodeFromBase256("npub", pubKey); err != nil {
+		return wrapErr(ErrDerivation, err)
+	}
+
+	if ad.PrivKeyEncoded, err = bech32.EncodeFromBase256("nsec", privKey.Serialize()); err != nil {
+		return wrapErr(ErrDerivation, err)
+	}
+
+	ad.PubKey = common.Bytes2Hex(pubKey)
+	ad.PrivKey = common.Bytes2Hex(privKey.Serialize())
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package bip39gen
+
+import (
+	"testing"
+)
+
+func TestNostr(t *testing.T) {
+	// test vectors from NIP-06
+	tests := []struct {
+		mnemonic string
+		privKey  string
+		npub     string
+		nsec     string
+	}{
+		{
+			mnemonic: "leader monkey parrot ring guide accident before fence cannon height naive bean",
+			privKey:  "7f7ff03d123792d6ac594bfa67bf6d0c0ab55b6b1fdb6249303fe861f1ccba9a",
+			npub:     "npub1zutzeysacnf9rru6zqwmxd54mud0k44tst6l70ja5mhv8jjumytsd2x7nu",
+			nsec:     "nsec10allq0gjx7fddtzef0ax00mdps9t2kmtrldkyjfs8l5xruwvh2dq0lhhkp",
+		},
+		{
+			mnemonic: "what bleak badge arrange retreat wolf trade produce cricket blur garlic valid proud rude strong choose busy staff weather area salt hollow arm fade",
+			privKey:  "c15d739894c81a2fcfd3a2df85a0d2c0dbc47a280d092799f144d73d7ae78add",
+			npub:     "npub16sdj9zv4f8sl85e45vgq9n7nsgt5qphpvmf7vk8r5hhvmdjxx4es8rq74h",
+			nsec:     "nsec1c9wh8xy5eqdzln7n5t0ctgxjcrdug73gp5yj0x03gntn67h83twssdfhel",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.npub, func(t *testing.T) {
+			addr := deriveTestAddress(t, Nostr, tt.mnemonic, 0)
+
+			if addr.PrivKey != tt.privKey {
+				t.Errorf("got private key %s, want %s", addr.PrivKey, tt.privKey)
+			}
+
+			if addr.Address != tt.npub {
+				t.Errorf("got npub %s, want %s", addr.Address, tt.npub)
+			}
+
+			if addr.PrivKeyEncoded != tt.nsec {
+				t.Errorf("got nsec %s, want %s", addr.PrivKeyEncoded, tt.nsec)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package bip39gen
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// parallelize calls fn for every i in [0, num) using up to workers goroutines.
+// Once any call returns an error no new calls are started,
+// and the first error encountered is returned.
+func parallelize(num, workers int, fn func(i int) error) error {
+	if workers > num {
+		workers = num
+	}
+
+	if workers <= 1 {
+		for i := 0; i < num; i++ {
+			if err := fn(i); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	var (
+		wg       sync.WaitGroup
+		next     int64 = -1
+		stopped  int32
+		errOnce  sync.Once
+		firstErr error
+	)
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for atomic.LoadInt32(&stopped) == 0 {
+				i := int(atomic.AddInt64(&next, 1))
+				if i >= num {
+					return
+				}
+
+				if err := fn(i); err != nil {
+					errOnce.Do(func() {
+						firstErr = err
+						atomic.StoreInt32(&stopped, 1)
+					})
+
+					return
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return firstErr
+}
@@ -0,0 +1,201 @@
+package bip39gen
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcutil/hdkeychain"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/pkg/errors"
+)
+
+const (
+	// AccountPlaceholder is replaced by the account index
+	// when a PathTemplate is resolved.
+	AccountPlaceholder string = "{account}"
+	// IndexPlaceholder is replaced by the wallet index
+	// when a PathTemplate is resolved.
+	IndexPlaceholder string = "{index}"
+)
+
+// DefaultPathTemplate is the standard BIP44 Ethereum derivation path.
+var DefaultPathTemplate = MustParsePathTemplate("m/44'/60'/0'/0/" + IndexPlaceholder)
+
+type pathComponent struct {
+	value       uint32
+	placeholder string
+	hardened    bool
+}
+
+// PathTemplate is a BIP32 derivation path in which the account and/or
+// wallet index components are placeholders, such as m/44'/60'/{account}'/0/{index}.
+// Use ParsePathTemplate to construct one.
+type PathTemplate struct {
+	components []pathComponent
+}
+
+// ParsePathTemplate parses and validates a derivation path template.
+// Hardened components may be written using either ' or h notation.
+// The template must contain exactly one IndexPlaceholder component,
+// and may contain at most one AccountPlaceholder component.
+func ParsePathTemplate(template string) (PathTemplate, error) {
+	var (
+		t           PathTemplate
+		split       = strings.Split(strings.TrimSpace(template), "/")
+		numAccounts int
+		numIndices  int
+	)
+
+	if split[0] != "m" {
+		return PathTemplate{}, wrapErr(ErrInvalidPathTemplate, errors.Errorf("%q must start with \"m/\"", template))
+	}
+
+	if len(split) == 1 {
+		return PathTemplate{}, wrapErr(ErrInvalidPathTemplate, errors.Errorf("%q has no path components", template))
+	}
+
+	for _, raw := range split[1:] {
+		var c pathComponent
+
+		if trimmed := strings.TrimRight(raw, "'hH"); len(raw)-len(trimmed) == 1 {
+			c.hardened = true
+			raw = trimmed
+		}
+
+		switch raw {
+		case AccountPlaceholder:
+			c.placeholder = raw
+			numAccounts++
+		case IndexPlaceholder:
+			c.placeholder = raw
+			numIndices++
+		default:
+			val, err := strconv.ParseUint(raw, 10, 32)
+			if err != nil || val >= hdkeychain.HardenedKeyStart {
+				return PathTemplate{}, wrapErr(ErrInvalidPathTemplate, errors.Errorf("invalid path component %q in %q", raw, template))
+			}
+
+			c.value = uint32(val)
+		}
+
+		t.components = append(t.components, c)
+	}
+
+	if numIndices != 1 || numAccounts > 1 {
+		return PathTemplate{}, wrapErr(ErrInvalidPathTemplate, errors.Errorf(
+			"%q must contain exactly one %s component and at most one %s component",
+			template, IndexPlaceholder, AccountPlaceholder,
+		))
+	}
+
+	return t, nil
+}
+
+// MustParsePathTemplate is like ParsePathTemplate, but panics
+// if the template can't be parsed.
+func MustParsePathTemplate(template string) PathTemplate {
+	t, err := ParsePathTemplate(template)
+	if err != nil {
+		panic(err)
+	}
+
+	return t
+}
+
+// String returns the template using ' notation for hardened components.
+func (t PathTemplate) String() string {
+	var b strings.Builder
+
+	b.WriteString("m")
+
+	for _, c := range t.components {
+		b.WriteString("/")
+
+		if c.placeholder != "" {
+			b.WriteString(c.placeholder)
+		} else {
+			b.WriteString(strconv.FormatUint(uint64(c.value), 10))
+		}
+
+		if c.hardened {
+			b.WriteString("'")
+		}
+	}
+
+	return b.String()
+}
+
+// Resolve returns the derivation path for the given account and wallet index.
+// If hardenIndex is true, the wallet index component is hardened
+// even if the template doesn't mark it as such.
+func (t PathTemplate) Resolve(account, index uint32, hardenIndex bool) accounts.DerivationPath {
+	path := make(accounts.DerivationPath, len(t.components))
+
+	for i, c := range t.components {
+		var (
+			val      = c.value
+			hardened = c.hardened
+		)
+
+		switch c.placeholder {
+		case AccountPlaceholder:
+			val = account
+		case IndexPlaceholder:
+			val = index
+			hardened = hardened || hardenIndex
+		}
+
+		if hardened {
+			val += hdkeychain.HardenedKeyStart
+		}
+
+		path[i] = val
+	}
+
+	return path
+}
+
+// IndexHardened returns whether the wallet index component of the
+// path resolved using hardenIndex is hardened.
+func (t PathTemplate) IndexHardened(hardenIndex bool) bool {
+	return hardenIndex || t.components[t.indexPosition()].hardened
+}
+
+// hasHardened returns whether any component of the path
+// resolved using hardenIndex is hardened.
+func (t PathTemplate) hasHardened(hardenIndex bool) bool {
+	for _, c := range t.components {
+		if c.hardened || (hardenIndex && c.placeholder == IndexPlaceholder) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasAccount returns whether the template has an AccountPlaceholder component.
+func (t PathTemplate) hasAccount() bool {
+	for _, c := range t.components {
+		if c.placeholder == AccountPlaceholder {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (t PathTemplate) isZero() bool {
+	return t.components == nil
+}
+
+// indexPosition returns the position of the wallet index component,
+// which is also the number of components which don't depend on the wallet index.
+func (t PathTemplate) indexPosition() int {
+	for i, c := range t.components {
+		if c.placeholder == IndexPlaceholder {
+			return i
+		}
+	}
+
+	return len(t.components)
+}
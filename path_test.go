@@ -0,0 +1,95 @@
+package bip39gen
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParsePathTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		want     string
+		wantErr  error
+	}{
+		{name: "' notation", template: "m/44'/60'/0'/0/{index}", want: "m/44'/60'/0'/0/{index}"},
+		{name: "h notation", template: "m/44h/60H/0h/0/{index}h", want: "m/44'/60'/0'/0/{index}'"},
+		{name: "account", template: "m/44'/60'/{account}'/0/{index}", want: "m/44'/60'/{account}'/0/{index}"},
+		{name: "largest component", template: "m/2147483647/{index}", want: "m/2147483647/{index}"},
+		{name: "missing index", template: "m/44'/60'/0'/0", wantErr: ErrInvalidPathTemplate},
+		{name: "duplicate index", template: "m/44'/60'/{index}'/0/{index}", wantErr: ErrInvalidPathTemplate},
+		{name: "duplicate account", template: "m/44'/{account}'/{account}'/{index}", wantErr: ErrInvalidPathTemplate},
+		{name: "component too large", template: "m/2147483648/{index}", wantErr: ErrInvalidPathTemplate},
+		{name: "double hardened", template: "m/44''/{index}", wantErr: ErrInvalidPathTemplate},
+		{name: "no root", template: "44'/60'/0'/0/{index}", wantErr: ErrInvalidPathTemplate},
+		{name: "no components", template: "m", wantErr: ErrInvalidPathTemplate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePathTemplate(tt.template)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr == nil && got.String() != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPathTemplateResolve(t *testing.T) {
+	tests := []struct {
+		name        string
+		template    string
+		account     uint32
+		index       uint32
+		hardenIndex bool
+		want        string
+	}{
+		{name: "default", template: "m/44'/60'/0'/0/{index}", index: 5, want: "m/44'/60'/0'/0/5"},
+		{name: "harden index", template: "m/44'/60'/0'/0/{index}", index: 5, hardenIndex: true, want: "m/44'/60'/0'/0/5'"},
+		{name: "hardened template index", template: "m/44'/501'/{index}'/0'", index: 2, want: "m/44'/501'/2'/0'"},
+		{name: "account", template: "m/44'/60'/{account}'/0/{index}", account: 3, index: 1, want: "m/44'/60'/3'/0/1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MustParsePathTemplate(tt.template).Resolve(tt.account, tt.index, tt.hardenIndex)
+			if got.String() != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithAccount(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		wantPath string
+		wantErr  error
+	}{
+		{name: "account placeholder", template: "m/44'/60'/{account}'/0/{index}", wantPath: "m/44'/60'/7'/0/0"},
+		{name: "no account placeholder", template: "m/44'/60'/0'/0/{index}", wantErr: ErrInvalidPathTemplate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			addr, err := NewGenerator(
+				WithMnemonic(testMnemonic),
+				WithPathTemplate(MustParsePathTemplate(tt.template)),
+				WithAccount(7),
+				WithIndexRange(0, 0),
+			).GenerateAddress()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr == nil && addr.DerivationPath != tt.wantPath {
+				t.Errorf("got path %s, want %s", addr.DerivationPath, tt.wantPath)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package bip39gen
+
+import (
+	"sort"
+)
+
+// PathPreset is a named derivation path template matching
+// the addresses a particular wallet shows for a mnemonic.
+type PathPreset struct {
+	Name     string
+	Template PathTemplate
+}
+
+var (
+	// PresetMetaMask derives addresses at m/44'/60'/0'/0/{index}.
+	PresetMetaMask = PathPreset{"metamask", DefaultPathTemplate}
+	// PresetTrezor derives addresses at m/44'/60'/0'/0/{index}.
+	PresetTrezor = PathPreset{"trezor", DefaultPathTemplate}
+	// PresetLedgerLive derives addresses at m/44'/60'/{index}'/0/0.
+	PresetLedgerLive = PathPreset{"ledger-live", MustParsePathTemplate("m/44'/60'/{index}'/0/0")}
+	// PresetLegacyMEW derives addresses at m/44'/60'/0'/{index},
+	// as used by MyEtherWallet and the Ledger Chrome app before BIP44 adoption.
+	PresetLegacyMEW = PathPreset{"legacy-mew", MustParsePathTemplate("m/44'/60'/0'/{index}")}
+)
+
+var pathPresets = map[string]PathPreset{
+	PresetMetaMask.Name:   PresetMetaMask,
+	PresetTrezor.Name:     PresetTrezor,
+	PresetLedgerLive.Name: PresetLedgerLive,
+	PresetLegacyMEW.Name:  PresetLegacyMEW,
+}
+
+// PathPresetByName returns the PathPreset with the given name,
+// and whether such a preset exists.
+func PathPresetByName(name string) (PathPreset, bool) {
+	preset, ok := pathPresets[name]
+	return preset, ok
+}
+
+// PathPresetNames returns the names of all available PathPresets, sorted.
+func PathPresetNames() []string {
+	names := make([]string, 0, len(pathPresets))
+
+	for name := range pathPresets {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
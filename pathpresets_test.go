@@ -0,0 +1,74 @@
+package bip39gen
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPathPresets(t *testing.T) {
+	tests := []struct {
+		name        string
+		preset      PathPreset
+		hardened    bool
+		wantPath    string
+		wantAddress string
+		wantErr     error
+	}{
+		{
+			name:        "metamask",
+			preset:      PresetMetaMask,
+			wantPath:    "m/44'/60'/0'/0/0",
+			wantAddress: "0x9858EfFD232B4033E47d90003D41EC34EcaEda94",
+		},
+		{
+			name:        "ledger-live",
+			preset:      PresetLedgerLive,
+			wantPath:    "m/44'/60'/0'/0/0",
+			wantAddress: "0x9858EfFD232B4033E47d90003D41EC34EcaEda94",
+		},
+		{
+			name:        "ledger-live hardened",
+			preset:      PresetLedgerLive,
+			hardened:    true,
+			wantPath:    "m/44'/60'/0'/0/0",
+			wantAddress: "0x9858EfFD232B4033E47d90003D41EC34EcaEda94",
+		},
+		{
+			name:     "legacy-mew",
+			preset:   PresetLegacyMEW,
+			wantPath: "m/44'/60'/0'/0",
+		},
+		{
+			name:     "legacy-mew hardened",
+			preset:   PresetLegacyMEW,
+			hardened: true,
+			wantErr:  ErrInvalidPathTemplate,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			addr, err := NewGenerator(
+				WithMnemonic(testMnemonic),
+				WithPathPreset(tt.preset),
+				WithHardened(tt.hardened),
+				WithIndexRange(0, 0),
+			).GenerateAddress()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if addr.DerivationPath != tt.wantPath || addr.PathPreset != tt.preset.Name {
+				t.Errorf("got path %s and preset %s, want %s and %s", addr.DerivationPath, addr.PathPreset, tt.wantPath, tt.preset.Name)
+			}
+
+			if tt.wantAddress != "" && addr.Address != tt.wantAddress {
+				t.Errorf("got address %s, want %s", addr.Address, tt.wantAddress)
+			}
+		})
+	}
+}
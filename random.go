@@ -0,0 +1,24 @@
+package bip39gen
+
+import (
+	"crypto/rand"
+	"io"
+	"math/big"
+
+	"github.com/pkg/errors"
+)
+
+// randomInt returns a uniformly distributed integer in
+// the closed interval [min, max], read from r.
+func randomInt(r io.Reader, min, max int) (int, error) {
+	if max < min {
+		return 0, errors.Errorf("invalid range [%d, %d]", min, max)
+	}
+
+	n, err := rand.Int(r, big.NewInt(int64(max-min)+1))
+	if err != nil {
+		return 0, wrapErr(ErrEntropySource, err)
+	}
+
+	return min + int(n.Int64()), nil
+}
@@ -0,0 +1,45 @@
+package bip39gen
+
+import (
+	"crypto/ed25519"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil/base58"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Solana is the Chain for Solana, deriving ed25519 keys
+// using SLIP-0010 at the same paths as Phantom and solana-keygen.
+//
+// Addresses are base58 encoded public keys. PrivKey is the 32-byte
+// ed25519 seed, and PrivKeyEncoded is the base58 encoded 64-byte
+// secret key (seed followed by public key) which Phantom imports
+// and solana-keygen stores as a JSON array of bytes.
+var Solana Chain = solanaChain{}
+
+type solanaChain struct {
+	slip10Engine
+}
+
+func (solanaChain) Name() string {
+	return "solana"
+}
+
+// DefaultPathTemplate returns m/44'/501'/{index}'/0'.
+func (solanaChain) DefaultPathTemplate() PathTemplate {
+	return MustParsePathTemplate(fmt.Sprintf("m/44'/501'/%s'/0'", IndexPlaceholder))
+}
+
+func (solanaChain) fillAddressData(key hdKey, ad *AddressData) error {
+	var (
+		privKey = ed25519PrivKey(key)
+		pubKey  = privKey.Public().(ed25519.PublicKey)
+	)
+
+	ad.Address = base58.Encode(pubKey)
+	ad.PubKey = common.Bytes2Hex(pubKey)
+	ad.PrivKey = common.Bytes2Hex(privKey.Seed())
+	ad.PrivKeyEncoded = base58.Encode(privKey)
+
+	return nil
+}
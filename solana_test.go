@@ -0,0 +1,17 @@
+package bip39gen
+
+import (
+	"testing"
+)
+
+func TestSolana(t *testing.T) {
+	addr := deriveTestAddress(t, Solana, testMnemonic, 0)
+
+	if want := "m/44'/501'/0'/0'"; addr.DerivationPath != want {
+		t.Errorf("got path %s, want %s", addr.DerivationPath, want)
+	}
+
+	if want := "HAgk14JpMQLgt6rVgv7cBQFJWFto5Dqxi472uT3DKpqk"; addr.Address != want {
+		t.Errorf("got address %s, want %s", addr.Address, want)
+	}
+}
@@ -0,0 +1,182 @@
+package bip39gen
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+)
+
+// Starknet is the Chain for Starknet and StarkEx, deriving secp256k1 keys
+// from the BIP39 seed and grinding them into Stark curve private keys.
+// Use EIP2645PathTemplate to derive keys at StarkEx's EIP-2645 paths.
+//
+// Argent X keys aren't supported, as Argent X seeds its BIP32 master key
+// with the Ethereum private key at m/44'/60'/0'/0/0 rather than the BIP39 seed.
+//
+// Starknet account addresses depend on the account contract they're
+// deployed with, so Address is the Stark public key, i.e. the x coordinate
+// of the public point, which is how StarkEx identifies accounts.
+var Starknet Chain = starknetChain{}
+
+type starknetChain struct {
+	bip32Engine
+}
+
+func (starknetChain) Name() string {
+	return "starknet"
+}
+
+// DefaultPathTemplate returns m/44'/9004'/{account}'/0/{index}.
+func (starknetChain) DefaultPathTemplate() PathTemplate {
+	return MustParsePathTemplate(fmt.Sprintf("m/44'/9004'/%s'/0/%s", AccountPlaceholder, IndexPlaceholder))
+}
+
+// eip2645Mask masks the 31 bits of a value used as an EIP-2645 path component.
+const eip2645Mask uint64 = 1<<31 - 1
+
+// EIP2645PathTemplate returns the EIP-2645 path template
+// m/2645'/layer'/application'/eth_address_1'/eth_address_2'/{index}, where
+// layer and application are the lowest 31 bits of the SHA-256 hashes of
+// the layer and application names, e.g. "starkex", and eth_address_1 and
+// eth_address_2 are the lowest and next 31 bits of ethAddress.
+func EIP2645PathTemplate(layer, application string, ethAddress common.Address) PathTemplate {
+	nameComponent := func(name string) uint64 {
+		digest := sha256.Sum256([]byte(name))
+		return binary.BigEndian.Uint64(digest[24:]) & eip2645Mask
+	}
+
+	addrBits := binary.BigEndian.Uint64(ethAddress[common.AddressLength-8:])
+
+	return MustParsePathTemplate(fmt.Sprintf(
+		"m/2645'/%d'/%d'/%d'/%d'/%s",
+		nameComponent(layer), nameComponent(application),
+		addrBits&eip2645Mask, (addrBits>>31)&eip2645Mask, IndexPlaceholder,
+	))
+}
+
+func (starknetChain) fillAddressData(key hdKey, ad *AddressData) error {
+	privKey, err := bip32PrivKey(key)
+	if err != nil {
+		return err
+	}
+
+	var (
+		starkPrivKey = grindStarkKey(privKey.Serialize())
+		starkPubKey  = starkCurve.scalarBaseMult(starkPrivKey)
+	)
+
+	ad.Address = "0x" + common.Bytes2Hex(math.PaddedBigBytes(starkPubKey, 32))
+	ad.PubKey = common.Bytes2Hex(math.PaddedBigBytes(starkPubKey, 32))
+	ad.PrivKey = common.Bytes2Hex(math.PaddedBigBytes(starkPrivKey, 32))
+
+	return nil
+}
+
+// grindStarkKey derives a Stark curve private key from keySeed by hashing it
+// with an increasing index until the hash is below the largest multiple
+// of the curve order less than 2^256, so that reducing it is unbiased.
+func grindStarkKey(keySeed []byte) *big.Int {
+	var (
+		limit  = new(big.Int).Lsh(big.NewInt(1), 256)
+		maxKey = new(big.Int).Sub(limit, new(big.Int).Mod(limit, starkCurve.n))
+		key    = new(big.Int)
+	)
+
+	for i := int64(0); ; i++ {
+		// the index is appended using as few bytes as possible,
+		// but at least one
+		index := big.NewInt(i).Bytes()
+		if len(index) == 0 {
+			index = []byte{0x00}
+		}
+
+		digest := sha256.Sum256(append(append([]byte{}, keySeed...), index...))
+
+		key.SetBytes(digest[:])
+		if key.Cmp(maxKey) < 0 {
+			return key.Mod(key, starkCurve.n)
+		}
+	}
+}
+
+// starkCurve is the Stark curve, y^2 = x^3 + alpha*x + beta over the field of order p.
+var starkCurve = shortWeierstrassCurve{
+	p:     hexBig("800000000000011000000000000000000000000000000000000000000000001"),
+	n:     hexBig("800000000000010ffffffffffffffffb781126dcae7b2321e66a241adc64d2f"),
+	alpha: big.NewInt(1),
+	gx:    hexBig("1ef15c18599971b7beced415a40f0c7deacfd9b0d1819e03d723d8bc943cfca"),
+	gy:    hexBig("5668060aa49730b7be4801df46ec62de53ecd11abe43a32873000c36e8dc1f"),
+}
+
+// shortWeierstrassCurve implements the affine point arithmetic needed
+// to compute public keys on curves which crypto/elliptic doesn't support.
+// It isn't constant time.
+type shortWeierstrassCurve struct {
+	p, n, alpha, gx, gy *big.Int
+}
+
+// scalarBaseMult returns the x coordinate of k*G.
+func (c shortWeierstrassCurve) scalarBaseMult(k *big.Int) *big.Int {
+	var (
+		x, y     *big.Int
+		infinity = true
+		px, py   = c.gx, c.gy
+	)
+
+	for i := 0; i < k.BitLen(); i++ {
+		if k.Bit(i) == 1 {
+			if infinity {
+				x, y, infinity = px, py, false
+			} else {
+				x, y = c.add(x, y, px, py)
+			}
+		}
+
+		px, py = c.add(px, py, px, py)
+	}
+
+	return x
+}
+
+// add returns the sum of two points, neither of which may be the point
+// at infinity, and which must not be each other's negation.
+func (c shortWeierstrassCurve) add(x1, y1, x2, y2 *big.Int) (*big.Int, *big.Int) {
+	var slope *big.Int
+
+	if x1.Cmp(x2) == 0 {
+		// (3x^2 + alpha) / 2y
+		num := new(big.Int).Mul(x1, x1)
+		num.Mul(num, big.NewInt(3)).Add(num, c.alpha)
+		den := new(big.Int).Lsh(y1, 1)
+		slope = num.Mul(num, den.ModInverse(den, c.p))
+	} else {
+		// (y2 - y1) / (x2 - x1)
+		num := new(big.Int).Sub(y2, y1)
+		den := new(big.Int).Sub(x2, x1)
+		den.Mod(den, c.p)
+		slope = num.Mul(num, den.ModInverse(den, c.p))
+	}
+
+	slope.Mod(slope, c.p)
+
+	x3 := new(big.Int).Mul(slope, slope)
+	x3.Sub(x3, x1).Sub(x3, x2).Mod(x3, c.p)
+
+	y3 := new(big.Int).Sub(x1, x3)
+	y3.Mul(y3, slope).Sub(y3, y1).Mod(y3, c.p)
+
+	return x3, y3
+}
+
+func hexBig(s string) *big.Int {
+	n, ok := new(big.Int).SetString(s, 16)
+	if !ok {
+		panic("invalid hex integer " + s)
+	}
+
+	return n
+}
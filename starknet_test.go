@@ -0,0 +1,67 @@
+package bip39gen
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestGrindStarkKey(t *testing.T) {
+	// known-answer test vectors
+	const (
+		keySeed = "86F3E7293141F20A8BAFF320E8EE4ACCB9D4A4BF2B4D295E8CEE784DB46E0519"
+		want    = "5c8c8683596c732541a59e03007b2d30dbbbb873556fe65b5fb63c16688f941"
+	)
+
+	if got := grindStarkKey(common.Hex2Bytes(keySeed)); got.Cmp(hexBig(want)) != 0 {
+		t.Errorf("got %x, want %s", got, want)
+	}
+}
+
+func TestStarkPublicKey(t *testing.T) {
+	// known-answer test vectors
+	tests := []struct {
+		privKey string
+		pubKey  string
+	}{
+		{
+			privKey: "019800ea6a9a73f94aee6a3d2edf018fc770443e90c7ba121e8303ec6b349279",
+			pubKey:  "33f45f07e1bd1a51b45fc24ec8c8c9908db9e42191be9e169bfcac0c0d99745",
+		},
+		{
+			privKey: "3c1e9550e66958296d11b60f8e8e7a7ad990d07fa65d5f7652c4a6c87d4e3cc",
+			pubKey:  "77a3b314db07c45076d11f62b6f9e748a39790441823307743cf00d6597ea43",
+		},
+	}
+
+	for _, tt := range tests {
+		if got := starkCurve.scalarBaseMult(hexBig(tt.privKey)); got.Cmp(hexBig(tt.pubKey)) != 0 {
+			t.Errorf("%s: got %x, want %s", tt.privKey, got, tt.pubKey)
+		}
+	}
+}
+
+func TestStarknetEIP2645(t *testing.T) {
+	// starkex-resources key derivation test vectors
+	const (
+		mnemonic = "range mountain blast problem vibrant void vivid doctor cluster enough melody salt layer language laptop boat major space monkey unit glimpse pause change vibrant"
+		path     = "m/2645'/579218131'/891216374'/1961790679'/2135936222'/0"
+		privKey  = "06cf0a8bf113352eb863157a45c5e5567abb34f8d32cddafd2c22aa803f4892c"
+	)
+
+	template := EIP2645PathTemplate("starkex", "starkdeployement", common.HexToAddress("0xa4864d977b944315389d1765ffa7e66F74ee8cd7"))
+
+	addr, err := NewGenerator(
+		WithChain(Starknet),
+		WithMnemonic(mnemonic),
+		WithPathTemplate(template),
+		WithIndexRange(0, 0),
+	).GenerateAddress()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if addr.DerivationPath != path || addr.PrivKey != privKey {
+		t.Errorf("got %s at %s, want %s at %s", addr.PrivKey, addr.DerivationPath, privKey, path)
+	}
+}
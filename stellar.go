@@ -0,0 +1,79 @@
+package bip39gen
+
+import (
+	"crypto/ed25519"
+	"encoding/base32"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Stellar is the Chain for Stellar, deriving ed25519 keys
+// using SLIP-0010 as specified by SEP-0005.
+//
+// Addresses are G... public key strkeys, and PrivKeyEncoded
+// is the S... secret seed strkey.
+var Stellar Chain = stellarChain{}
+
+// strkey version bytes, which determine the first character
+// of the encoded key.
+const (
+	strkeyPublicKey  byte = 6 << 3  // G
+	strkeySecretSeed byte = 18 << 3 // S
+)
+
+type stellarChain struct {
+	slip10Engine
+}
+
+func (stellarChain) Name() string {
+	return "stellar"
+}
+
+// DefaultPathTemplate returns m/44'/148'/{index}'.
+func (stellarChain) DefaultPathTemplate() PathTemplate {
+	return MustParsePathTemplate(fmt.Sprintf("m/44'/148'/%s'", IndexPlaceholder))
+}
+
+func (stellarChain) fillAddressData(key hdKey, ad *AddressData) error {
+	var (
+		privKey = ed25519PrivKey(key)
+		pubKey  = privKey.Public().(ed25519.PublicKey)
+	)
+
+	ad.Address = encodeStrkey(strkeyPublicKey, pubKey)
+	ad.PubKey = common.Bytes2Hex(pubKey)
+	ad.PrivKey = common.Bytes2Hex(privKey.Seed())
+	ad.PrivKeyEncoded = encodeStrkey(strkeySecretSeed, privKey.Seed())
+
+	return nil
+}
+
+// encodeStrkey encodes payload as a strkey, i.e. the unpadded base32
+// encoding of the version byte, payload, and little-endian CRC16 checksum.
+func encodeStrkey(version byte, payload []byte) string {
+	data := append([]byte{version}, payload...)
+	checksum := crc16XModem(data)
+	data = append(data, byte(checksum), byte(checksum>>8))
+
+	return base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(data)
+}
+
+// crc16XModem computes the CRC16-XModem checksum of data.
+func crc16XModem(data []byte) uint16 {
+	var crc uint16
+
+	for _, b := range data {
+		crc ^= uint16(b) << 8
+
+		for i := 0; i < 8; i++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+
+	return crc
+}
@@ -0,0 +1,43 @@
+package bip39gen
+
+import (
+	"testing"
+)
+
+func TestStellar(t *testing.T) {
+	// SEP-0005 test vectors
+	tests := []struct {
+		mnemonic string
+		idx      int
+		address  string
+		secret   string
+	}{
+		{
+			mnemonic: "illness spike retreat truth genius clock brain pass fit cave bargain toe",
+			idx:      0,
+			address:  "GDRXE2BQUC3AZNPVFSCEZ76NJ3WWL25FYFK6RGZGIEKWE4SOOHSUJUJ6",
+			secret:   "SBGWSG6BTNCKCOB3DIFBGCVMUPQFYPA2G4O34RMTB343OYPXU5DJDVMN",
+		},
+		{
+			mnemonic: "illness spike retreat truth genius clock brain pass fit cave bargain toe",
+			idx:      1,
+			address:  "GBAW5XGWORWVFE2XTJYDTLDHXTY2Q2MO73HYCGB3XMFMQ562Q2W2GJQX",
+			secret:   "SCEPFFWGAG5P2VX5DHIYK3XEMZYLTYWIPWYEKXFHSK25RVMIUNJ7CTIS",
+		},
+		{
+			mnemonic: testMnemonic,
+			idx:      0,
+			address:  "GB3JDWCQJCWMJ3IILWIGDTQJJC5567PGVEVXSCVPEQOTDN64VJBDQBYX",
+			secret:   "SBUV3MRWKNS6AYKZ6E6MOUVF2OYMON3MIUASWL3JLY5E3ISDJFELYBRZ",
+		},
+	}
+
+	for _, tt := range tests {
+		addr := deriveTestAddress(t, Stellar, tt.mnemonic, tt.idx)
+
+		if addr.Address != tt.address || addr.PrivKeyEncoded != tt.secret {
+			t.Errorf("%s index %d: got %s / %s, want %s / %s",
+				tt.mnemonic, tt.idx, addr.Address, addr.PrivKeyEncoded, tt.address, tt.secret)
+		}
+	}
+}
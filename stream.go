@@ -0,0 +1,204 @@
+package bip39gen
+
+import (
+	"context"
+)
+
+// streamBatchSize is the number of addresses each worker generates
+// before a batch is sent, in order, to a Stream's consumer.
+const streamBatchSize int = 16
+
+// StreamResult is a single result sent by Generator.Stream
+// and Generator.StreamSingleMnemonic.
+// If Err is non-nil, it's the last result sent.
+type StreamResult struct {
+	Address AddressData
+	Err     error
+}
+
+// Stream generates num addresses the same way GenerateAddresses does,
+// but sends each address on the returned channel as soon as it's available
+// instead of returning them all at once.
+// Addresses are sent in order, and the channel is closed once num addresses
+// have been sent, an error has been sent, or ctx is done.
+func (g *Generator) Stream(ctx context.Context, num int) <-chan StreamResult {
+	var (
+		singleMnemonic = g.opts.mnemonic != "" || g.opts.entropy != nil
+		err            = checkAddressCount(num)
+	)
+
+	if err == nil {
+		err = g.checkPathTemplate()
+	}
+
+	if err == nil {
+		err = g.checkKeysPerMnemonic(num, singleMnemonic)
+	}
+
+	if err == nil && singleMnemonic {
+		err = g.checkIndexRangeSize(num)
+	}
+
+	if err != nil {
+		return g.stream(ctx, 1, func(int) (addressKey, addressJob, error) {
+			return addressKey{}, nil, err
+		})
+	}
+
+	return g.stream(ctx, num, func(int) (addressKey, addressJob, error) {
+		return g.drawAddress()
+	})
+}
+
+// StreamSingleMnemonic is GenerateAddressesSingleMnemonic's counterpart to Stream.
+func (g *Generator) StreamSingleMnemonic(ctx context.Context, num int) <-chan StreamResult {
+	var (
+		node    *hdNode
+		entropy []byte
+		err     = checkAddressCount(num)
+	)
+
+	if err == nil {
+		entropy, err = g.opts.drawEntropy()
+	}
+
+	if err == nil {
+		node, err = g.newNode(entropy)
+	}
+
+	if err == nil {
+		err = g.checkKeysPerMnemonic(num, true)
+	}
+
+	if err == nil && g.opts.indexStrategy != SequentialIndex {
+		err = g.checkIndexRangeSize(num)
+	}
+
+	if err != nil {
+		return g.stream(ctx, 1, func(int) (addressKey, addressJob, error) {
+			return addressKey{}, nil, err
+		})
+	}
+
+	return g.stream(ctx, num, func(i int) (addressKey, addressJob, error) {
+		var (
+			idx  = i
+			name string
+			err  error
+		)
+
+		if g.opts.indexStrategy != SequentialIndex {
+			randIdx, err := g.randomIndex()
+			if err != nil {
+				return addressKey{}, nil, err
+			}
+
+			idx = randIdx
+		}
+
+		if g.opts.genName {
+			if name, err = genNameFromMnemonic(g.opts.randSource, node.wallet.Mnemonic()); err != nil {
+				return addressKey{}, nil, err
+			}
+		}
+
+		return addressKey{index: idx}, func() (AddressData, error) {
+			return makeDerivedAddress(node, idx, name)
+		}, nil
+	})
+}
+
+// stream calls draw for every i in [0, num) in order, runs the returned jobs
+// one batch of streamBatchSize addresses per worker at a time,
+// and sends the results in order.
+// draw is called again for any address whose key was already drawn,
+// before any more addresses are drawn, so that the random source is read
+// in the same order regardless of the number of workers.
+func (g *Generator) stream(ctx context.Context, num int, draw func(i int) (addressKey, addressJob, error)) <-chan StreamResult {
+	var (
+		results = make(chan StreamResult)
+		jobs    = make([]addressJob, streamBatchSize*g.opts.workers)
+		batch   = make([]AddressData, len(jobs))
+	)
+
+	send := func(res StreamResult) bool {
+		select {
+		case results <- res:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	go func() {
+		defer close(results)
+
+		var drawn = make(map[addressKey]bool)
+
+		for start := 0; start < num; start += len(batch) {
+			n := len(batch)
+			if remaining := num - start; remaining < n {
+				n = remaining
+			}
+
+			var err error
+
+			for i := 0; i < n && err == nil; i++ {
+				// make sure we never get the same address
+				for {
+					select {
+					case <-ctx.Done():
+						return
+					default:
+					}
+
+					var key addressKey
+
+					if key, jobs[i], err = draw(start + i); err != nil || !drawn[key] {
+						drawn[key] = true
+						break
+					}
+				}
+			}
+
+			if err == nil {
+				err = parallelize(n, g.opts.workers, func(i int) (err error) {
+					batch[i], err = jobs[i]()
+					return
+				})
+			}
+
+			if err != nil {
+				send(StreamResult{Err: err})
+				return
+			}
+
+			for _, addr := range batch[:n] {
+				if !send(StreamResult{Address: addr}) {
+					return
+				}
+			}
+		}
+	}()
+
+	return results
+}
+
+func collect(results <-chan StreamResult, num int) ([]AddressData, error) {
+	var addrs []AddressData
+
+	// num is checked by the stream, which sends an error if it's negative
+	if num > 0 {
+		addrs = make([]AddressData, 0, num)
+	}
+
+	for res := range results {
+		if res.Err != nil {
+			return nil, res.Err
+		}
+
+		addrs = append(addrs, res.Address)
+	}
+
+	return addrs, nil
+}
@@ -0,0 +1,114 @@
+package bip39gen
+
+import (
+	"bufio"
+	"encoding/csv"
+	"io"
+
+	"github.com/jalavosus/bip39gen/internal/datakeys"
+	"github.com/jalavosus/bip39gen/internal/outformat"
+)
+
+// StreamFormat is an output format supported by StreamWriter.
+type StreamFormat uint
+
+const (
+	// StreamNDJSON writes one JSON object per line.
+	StreamNDJSON StreamFormat = iota
+	// StreamCSV writes a header row followed by one row per address.
+	StreamCSV
+	// StreamText writes the same output as AddressDataOutputSlice.FormatText.
+	StreamText
+)
+
+// StreamWriter writes AddressDataOutput structs to an io.Writer one at a time,
+// flushing each as it's written, so that output for very large
+// batches never has to be held in memory all at once.
+type StreamWriter struct {
+	w       *bufio.Writer
+	csv     *csv.Writer
+	format  StreamFormat
+	fields  []string
+	written int
+}
+
+// NewStreamWriter returns a *StreamWriter writing to w in the given format.
+// Fields set to true in excludes are left out of CSV output.
+func NewStreamWriter(w io.Writer, format StreamFormat, excludes map[string]bool) *StreamWriter {
+	sw := &StreamWriter{
+		w:      bufio.NewWriter(w),
+		format: format,
+	}
+
+	if format == StreamCSV {
+		sw.csv = csv.NewWriter(sw.w)
+
+		for _, field := range datakeys.FieldOrder {
+			if !excludes[field] {
+				sw.fields = append(sw.fields, field)
+			}
+		}
+	}
+
+	return sw
+}
+
+// Write formats and flushes a single AddressDataOutput.
+func (s *StreamWriter) Write(a AddressDataOutput) error {
+	var err error
+
+	switch s.format {
+	case StreamNDJSON:
+		err = s.writeLine(outformat.NDJSON.Marshal(a))
+	case StreamCSV:
+		err = s.writeCSV(a)
+	case StreamText:
+		if s.written > 0 {
+			err = s.w.WriteByte('\n')
+		}
+
+		if err == nil {
+			_, err = s.w.Write(a.FormatText())
+		}
+	}
+
+	if err != nil {
+		return err
+	}
+
+	s.written++
+
+	return s.w.Flush()
+}
+
+func (s *StreamWriter) writeLine(line []byte) error {
+	if _, err := s.w.Write(line); err != nil {
+		return err
+	}
+
+	return s.w.WriteByte('\n')
+}
+
+func (s *StreamWriter) writeCSV(a AddressDataOutput) error {
+	if s.written == 0 {
+		if err := s.csv.Write(s.fields); err != nil {
+			return err
+		}
+	}
+
+	record := make([]string, len(s.fields))
+
+	for i, field := range s.fields {
+		if val := a.fieldValue(field); val != nil {
+			record[i] = *val
+		}
+	}
+
+	if err := s.csv.Write(record); err != nil {
+		return err
+	}
+
+	s.csv.Flush()
+
+	return s.csv.Error()
+}
@@ -0,0 +1,117 @@
+package bip39gen
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/jalavosus/bip39gen/internal/datakeys"
+)
+
+func testStreamOutputs(t *testing.T, excludes map[string]bool) AddressDataOutputSlice {
+	t.Helper()
+
+	addrs, err := NewGenerator(
+		WithMnemonic(testMnemonic),
+		WithIndexStrategy(SequentialIndex),
+	).GenerateAddressesSingleMnemonic(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	outs := make(AddressDataOutputSlice, len(addrs))
+	for i, addr := range addrs {
+		outs[i] = addr.FormatOutput(excludes)
+	}
+
+	return outs
+}
+
+func writeStream(t *testing.T, format StreamFormat, excludes map[string]bool, outs AddressDataOutputSlice) string {
+	t.Helper()
+
+	var (
+		buf bytes.Buffer
+		sw  = NewStreamWriter(&buf, format, excludes)
+	)
+
+	for _, out := range outs {
+		if err := sw.Write(out); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return buf.String()
+}
+
+func TestStreamWriterCSV(t *testing.T) {
+	excludes := map[string]bool{
+		datakeys.Mnemonic: true,
+		datakeys.Privkey:  true,
+	}
+
+	outs := testStreamOutputs(t, excludes)
+
+	records, err := csv.NewReader(strings.NewReader(writeStream(t, StreamCSV, excludes, outs))).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(records) != len(outs)+1 {
+		t.Fatalf("got %d records, want a header and %d rows", len(records), len(outs))
+	}
+
+	header := records[0]
+	for _, field := range header {
+		if excludes[field] {
+			t.Errorf("excluded field %s is in the header", field)
+		}
+	}
+
+	if len(header) != len(datakeys.FieldOrder)-len(excludes) {
+		t.Errorf("got %d columns, want %d", len(header), len(datakeys.FieldOrder)-len(excludes))
+	}
+
+	for i, out := range outs {
+		for j, field := range header {
+			want := ""
+			if val := out.fieldValue(field); val != nil {
+				want = *val
+			}
+
+			if got := records[i+1][j]; got != want {
+				t.Errorf("row %d, column %s: got %q, want %q", i, field, got, want)
+			}
+		}
+	}
+}
+
+func TestStreamWriterNDJSON(t *testing.T) {
+	outs := testStreamOutputs(t, nil)
+	lines := strings.Split(strings.TrimSuffix(writeStream(t, StreamNDJSON, nil, outs), "\n"), "\n")
+
+	if len(lines) != len(outs) {
+		t.Fatalf("got %d lines, want %d", len(lines), len(outs))
+	}
+
+	for i, line := range lines {
+		var got AddressDataOutput
+		if err := json.Unmarshal([]byte(line), &got); err != nil {
+			t.Fatalf("line %d: %v", i, err)
+		}
+
+		if *got.Address != *outs[i].Address {
+			t.Errorf("line %d: got address %s, want %s", i, *got.Address, *outs[i].Address)
+		}
+	}
+}
+
+func TestStreamWriterText(t *testing.T) {
+	outs := testStreamOutputs(t, nil)
+
+	if got, want := writeStream(t, StreamText, nil, outs), string(outs.FormatText()); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
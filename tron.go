@@ -0,0 +1,47 @@
+package bip39gen
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil/base58"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Tron is the Chain for Tron, whose addresses are Ethereum addresses
+// prefixed by 0x41 and base58check encoded, e.g. T...
+// AddressHex is the prefixed address in hex, e.g. 41...
+var Tron Chain = tronChain{}
+
+// tronAddressPrefix is prepended to the 20-byte address.
+const tronAddressPrefix byte = 0x41
+
+type tronChain struct {
+	bip32Engine
+}
+
+func (tronChain) Name() string {
+	return "tron"
+}
+
+// DefaultPathTemplate returns m/44'/195'/{account}'/0/{index}.
+func (tronChain) DefaultPathTemplate() PathTemplate {
+	return MustParsePathTemplate(fmt.Sprintf("m/44'/195'/%s'/0/%s", AccountPlaceholder, IndexPlaceholder))
+}
+
+func (tronChain) fillAddressData(key hdKey, ad *AddressData) error {
+	privKey, err := bip32PrivKey(key)
+	if err != nil {
+		return err
+	}
+
+	ecdsaKey := privKey.ToECDSA()
+	addr := crypto.PubkeyToAddress(ecdsaKey.PublicKey)
+
+	ad.Address = base58.CheckEncode(addr.Bytes(), tronAddressPrefix)
+	ad.AddressHex = common.Bytes2Hex(append([]byte{tronAddressPrefix}, addr.Bytes()...))
+	ad.PubKey = common.Bytes2Hex(crypto.FromECDSAPub(&ecdsaKey.PublicKey)[1:])
+	ad.PrivKey = common.Bytes2Hex(crypto.FromECDSA(ecdsaKey))
+
+	return nil
+}
@@ -0,0 +1,21 @@
+package bip39gen
+
+import (
+	"testing"
+)
+
+func TestTron(t *testing.T) {
+	addr := deriveTestAddress(t, Tron, testMnemonic, 0)
+
+	if want := "m/44'/195'/0'/0/0"; addr.DerivationPath != want {
+		t.Errorf("got path %s, want %s", addr.DerivationPath, want)
+	}
+
+	if want := "TUEZSdKsoDHQMeZwihtdoBiN46zxhGWYdH"; addr.Address != want {
+		t.Errorf("got address %s, want %s", addr.Address, want)
+	}
+
+	if want := "41c8599111f29c1e1e061265b4af93ea1f274ad78a"; addr.AddressHex != want {
+		t.Errorf("got hex address %s, want %s", addr.AddressHex, want)
+	}
+}
@@ -0,0 +1,265 @@
+package bip39gen
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/base58"
+	"github.com/btcsuite/btcd/btcutil/bech32"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+// BitcoinAddressType is the type of address a UTXOChain generates.
+type BitcoinAddressType string
+
+const (
+	// P2PKH addresses are legacy pay-to-pubkey-hash addresses,
+	// derived along BIP44 paths.
+	P2PKH BitcoinAddressType = "p2pkh"
+	// P2SHP2WPKH addresses are nested segwit addresses,
+	// derived along BIP49 paths.
+	P2SHP2WPKH BitcoinAddressType = "p2sh-p2wpkh"
+	// P2WPKH addresses are native segwit (bech32) addresses,
+	// derived along BIP84 paths.
+	P2WPKH BitcoinAddressType = "p2wpkh"
+	// P2TR addresses are taproot (bech32m) key-path addresses,
+	// derived along BIP86 paths.
+	P2TR BitcoinAddressType = "p2tr"
+)
+
+// purpose returns the BIP43 purpose of paths addresses of type t are derived along.
+func (t BitcoinAddressType) purpose() uint32 {
+	switch t {
+	case P2SHP2WPKH:
+		return 49
+	case P2WPKH:
+		return 84
+	case P2TR:
+		return 86
+	default:
+		return 44
+	}
+}
+
+func (t BitcoinAddressType) segwit() bool {
+	return t != P2PKH
+}
+
+// BitcoinAddressTypes returns all BitcoinAddressTypes.
+func BitcoinAddressTypes() []BitcoinAddressType {
+	return []BitcoinAddressType{P2PKH, P2SHP2WPKH, P2WPKH, P2TR}
+}
+
+// UTXONetwork contains the parameters which distinguish the addresses
+// and keys of a bitcoin-like network from those of other networks.
+type UTXONetwork struct {
+	// Name is the name of the network, e.g. "mainnet".
+	Name string
+	// CoinType is the network's SLIP-44 coin type.
+	CoinType uint32
+	// PubKeyHashPrefix is prepended to the key hash of P2PKH addresses.
+	PubKeyHashPrefix []byte
+	// ScriptHashPrefix is prepended to the script hash of P2SH addresses.
+	ScriptHashPrefix []byte
+	// WIFPrefix is prepended to WIF-encoded private keys.
+	WIFPrefix byte
+	// Bech32HRP is the human-readable part of segwit addresses,
+	// and is empty if the network doesn't support segwit.
+	Bech32HRP string
+	// CashAddrPrefix is the prefix of CashAddr addresses,
+	// and is empty if the network doesn't support CashAddr.
+	CashAddrPrefix string
+}
+
+// UTXOChain is a Chain for bitcoin and coins which share its key derivation
+// and address encoding, generating addresses of a single BitcoinAddressType
+// for a single network.
+type UTXOChain struct {
+	// Coin is the name of the chain.
+	Coin string
+	// Network is the network addresses are generated for.
+	Network UTXONetwork
+	// AddressType is the type of address generated.
+	AddressType BitcoinAddressType
+	// AddressFormat is the encoding of generated addresses,
+	// and is only set for networks which support CashAddr.
+	AddressFormat AddressFormat
+
+	bip32Engine
+	networks []UTXONetwork
+}
+
+// newUTXOChain returns a UTXOChain generating addresses of type addressType
+// for the first of its networks, CashAddr encoded if the network supports it.
+func newUTXOChain(coin string, addressType BitcoinAddressType, networks ...UTXONetwork) UTXOChain {
+	c := UTXOChain{
+		Coin:        coin,
+		Network:     networks[0],
+		AddressType: addressType,
+		networks:    networks,
+	}
+
+	if c.Network.CashAddrPrefix != "" {
+		c.AddressFormat = CashAddrFormat
+	}
+
+	return c
+}
+
+func (c UTXOChain) Name() string {
+	return c.Coin
+}
+
+// DefaultPathTemplate returns m/purpose'/coin_type'/{account}'/0/{index},
+// where purpose depends on the chain's AddressType and coin_type
+// on its network.
+func (c UTXOChain) DefaultPathTemplate() PathTemplate {
+	return MustParsePathTemplate(fmt.Sprintf(
+		"m/%d'/%d'/%s'/0/%s",
+		c.AddressType.purpose(), c.Network.CoinType, AccountPlaceholder, IndexPlaceholder,
+	))
+}
+
+// Networks returns the names of the networks the chain supports.
+func (c UTXOChain) Networks() []string {
+	names := make([]string, len(c.networks))
+
+	for i, network := range c.networks {
+		names[i] = network.Name
+	}
+
+	return names
+}
+
+// WithNetwork returns a copy of the chain which generates
+// addresses for the named network.
+func (c UTXOChain) WithNetwork(network string) (UTXOChain, error) {
+	for _, n := range c.networks {
+		if n.Name == network {
+			c.Network = n
+
+			c, err := c.WithAddressType(c.AddressType)
+			if err != nil || c.AddressFormat == "" {
+				return c, err
+			}
+
+			return c.WithAddressFormat(c.AddressFormat)
+		}
+	}
+
+	return UTXOChain{}, wrapErr(ErrUnsupportedChain, errors.Errorf(
+		"%s has no network %q; supported networks: %v",
+		c.Coin, network, c.Networks(),
+	))
+}
+
+// WithAddressType returns a copy of the chain which generates
+// addresses of the given type.
+func (c UTXOChain) WithAddressType(addressType BitcoinAddressType) (UTXOChain, error) {
+	var known bool
+
+	for _, t := range BitcoinAddressTypes() {
+		known = known || t == addressType
+	}
+
+	if !known || (addressType.segwit() && c.Network.Bech32HRP == "") {
+		return UTXOChain{}, wrapErr(ErrUnsupportedChain, errors.Errorf(
+			"%s %s does not support %q addresses", c.Coin, c.Network.Name, addressType,
+		))
+	}
+
+	c.AddressType = addressType
+
+	return c, nil
+}
+
+// WithAddressFormat returns a copy of the chain which generates
+// addresses in the given format.
+// Only networks with a CashAddrPrefix support choosing a format.
+func (c UTXOChain) WithAddressFormat(format AddressFormat) (UTXOChain, error) {
+	if c.Network.CashAddrPrefix == "" || (format != LegacyFormat && format != CashAddrFormat) {
+		return UTXOChain{}, wrapErr(ErrUnsupportedChain, errors.Errorf(
+			"%s %s does not support %q address format", c.Coin, c.Network.Name, format,
+		))
+	}
+
+	c.AddressFormat = format
+
+	return c, nil
+}
+
+func (c UTXOChain) fillAddressData(key hdKey, ad *AddressData) error {
+	privKey, err := bip32PrivKey(key)
+	if err != nil {
+		return err
+	}
+
+	var (
+		pubKey  = privKey.PubKey().SerializeCompressed()
+		keyHash = btcutil.Hash160(pubKey)
+	)
+
+	switch c.AddressType {
+	case P2PKH:
+		if c.AddressFormat == CashAddrFormat {
+			ad.Address, err = encodeCashAddr(c.Network.CashAddrPrefix, cashAddrP2PKH, keyHash)
+		} else {
+			ad.Address = base58CheckEncode(c.Network.PubKeyHashPrefix, keyHash)
+		}
+	case P2SHP2WPKH:
+		// the redeem script is the P2WPKH witness program, OP_0 <20-byte key hash>
+		redeemScript := append([]byte{0x00, 0x14}, keyHash...)
+		ad.Address = base58CheckEncode(c.Network.ScriptHashPrefix, btcutil.Hash160(redeemScript))
+	case P2WPKH:
+		ad.Address, err = encodeSegwitAddress(c.Network.Bech32HRP, 0, keyHash)
+	case P2TR:
+		// BIP86 tweaks the internal key with an empty script tree,
+		// and both keys are identified by their x coordinate only.
+		outputKey := schnorr.SerializePubKey(txscript.ComputeTaprootKeyNoScript(privKey.PubKey()))
+		ad.Address, err = encodeSegwitAddress(c.Network.Bech32HRP, 1, outputKey)
+
+		pubKey = schnorr.SerializePubKey(privKey.PubKey())
+		ad.TweakedPubKey = common.Bytes2Hex(outputKey)
+	default:
+		err = errors.Errorf("unknown address type %q", c.AddressType)
+	}
+
+	if err != nil {
+		return wrapErr(ErrDerivation, err)
+	}
+
+	ad.Network = c.Network.Name
+	ad.AddressType = string(c.AddressType)
+	ad.AddressFormat = string(c.AddressFormat)
+	ad.PubKey = common.Bytes2Hex(pubKey)
+	ad.PrivKey = common.Bytes2Hex(privKey.Serialize())
+	// compressed WIF keys have a 0x01 suffix
+	ad.PrivKeyEncoded = base58CheckEncode([]byte{c.Network.WIFPrefix}, append(privKey.Serialize(), 0x01))
+
+	return nil
+}
+
+// base58CheckEncode encodes payload, prefixed by a version of one or more bytes.
+func base58CheckEncode(version, payload []byte) string {
+	return base58.CheckEncode(append(append([]byte{}, version[1:]...), payload...), version[0])
+}
+
+// encodeSegwitAddress encodes a witness program as a bech32 (version 0)
+// or bech32m (version 1+) segwit address.
+func encodeSegwitAddress(hrp string, version byte, program []byte) (string, error) {
+	converted, err := bech32.ConvertBits(program, 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+
+	data := append([]byte{version}, converted...)
+
+	if version == 0 {
+		return bech32.Encode(hrp, data)
+	}
+
+	return bech32.EncodeM(hrp, data)
+}
@@ -0,0 +1,102 @@
+package bip39gen
+
+import (
+	"testing"
+)
+
+func TestBitcoinTaproot(t *testing.T) {
+	// BIP86 test vectors, m/86'/0'/0'/0/0
+	chain, err := Bitcoin.WithAddressType(P2TR)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	addr := deriveTestAddress(t, chain, testMnemonic, 0)
+
+	want := AddressData{
+		Address:        "bc1p5cyxnuxmeuwuvkwfem96lqzszd02n6xdcjrs20cac6yqjjwudpxqkedrcr",
+		PubKey:         "cc8a4bc64d897bddc5fbc2f670f7a8ba0b386779106cf1223c6fc5d7cd6fc115",
+		TweakedPubKey:  "a60869f0dbcf1dc659c9cecbaf8050135ea9e8cdc487053f1dc6880949dc684c",
+		PrivKey:        "41f41d69260df4cf277826a9b65a3717e4eeddbeedf637f212ca096576479361",
+		PrivKeyEncoded: "KyRv5iFPHG7iB5E4CqvMzH3WFJVhbfYK4VY7XAedd9Ys69mEsPLQ",
+		DerivationPath: "m/86'/0'/0'/0/0",
+	}
+
+	if addr.Address != want.Address ||
+		addr.PubKey != want.PubKey ||
+		addr.TweakedPubKey != want.TweakedPubKey ||
+		addr.PrivKey != want.PrivKey ||
+		addr.PrivKeyEncoded != want.PrivKeyEncoded ||
+		addr.DerivationPath != want.DerivationPath {
+		t.Errorf("got %+v, want %+v", addr, want)
+	}
+}
+
+func TestUTXOChains(t *testing.T) {
+	tests := []struct {
+		chain       UTXOChain
+		network     string
+		addressType BitcoinAddressType
+		format      AddressFormat
+		path        string
+		address     string
+		wif         string
+	}{
+		{Bitcoin, "mainnet", P2PKH, "", "m/44'/0'/0'/0/0", "1LqBGSKuX5yYUonjxT5qGfpUsXKYYWeabA", "L4p2b9VAf8k5aUahF1JCJUzZkgNEAqLfq8DDdQiyAprQAKSbu8hf"},
+		{Bitcoin, "mainnet", P2SHP2WPKH, "", "m/49'/0'/0'/0/0", "37VucYSaXLCAsxYyAPfbSi9eh4iEcbShgf", "KyvHbRLNXfXaHuZb3QRaeqA5wovkjg4RuUpFGCxdH5UWc1Foih9o"},
+		{Bitcoin, "mainnet", P2WPKH, "", "m/84'/0'/0'/0/0", "bc1qcr8te4kr609gcawutmrza0j4xv80jy8z306fyu", "KyZpNDKnfs94vbrwhJneDi77V6jF64PWPF8x5cdJb8ifgg2DUc9d"},
+		{Bitcoin, "testnet", P2PKH, "", "m/44'/1'/0'/0/0", "mkpZhYtJu2r87Js3pDiWJDmPte2NRZ8bJV", "cV6NTLu255SZ5iCNkVHezNGDH5qv6CanJpgBPqYgJU13NNKJhRs1"},
+		{Bitcoin, "testnet", P2SHP2WPKH, "", "m/49'/1'/0'/0/0", "2Mww8dCYPUpKHofjgcXcBCEGmniw9CoaiD2", "cULrpoZGXiuC19Uhvykx7NugygA3k86b3hmdCeyvHYQZSxojGyXJ"},
+		{Bitcoin, "testnet", P2WPKH, "", "m/84'/1'/0'/0/0", "tb1q6rz28mcfaxtmd6v789l9rrlrusdprr9pqcpvkl", "cTGhosGriPpuGA586jemcuH9pE9spwUmneMBmYYzrQEbY92DJrbo"},
+		{Bitcoin, "testnet", P2TR, "", "m/86'/1'/0'/0/0", "tb1p8wpt9v4frpf3tkn0srd97pksgsxc5hs52lafxwru9kgeephvs7rqlqt9zj", "cV628xvqToz45dwdPmTcJ9RgEVnWMwP8dpZBGzb9LfTk3sBHFNwc"},
+		{Bitcoin, "regtest", P2WPKH, "", "m/84'/1'/0'/0/0", "bcrt1q6rz28mcfaxtmd6v789l9rrlrusdprr9pz3cppk", "cTGhosGriPpuGA586jemcuH9pE9spwUmneMBmYYzrQEbY92DJrbo"},
+		{BitcoinCash, "mainnet", P2PKH, CashAddrFormat, "m/44'/145'/0'/0/0", "bitcoincash:qqyx49mu0kkn9ftfj6hje6g2wfer34yfnq5tahq3q6", "KxbEv3FeYig2afQp7QEA9R3gwqdTBFwAJJ6Ma7j1SkmZoxC9bAXZ"},
+		{BitcoinCash, "mainnet", P2PKH, LegacyFormat, "m/44'/145'/0'/0/0", "1mW6fDEMjKrDHvLvoEsaeLxSCzZBf3Bfg", "KxbEv3FeYig2afQp7QEA9R3gwqdTBFwAJJ6Ma7j1SkmZoxC9bAXZ"},
+		{BitcoinCash, "testnet", P2PKH, CashAddrFormat, "m/44'/1'/0'/0/0", "bchtest:qqaz6s295ncfs53m86qj0uw6sl8u2kuw0ymst35fx4", "cV6NTLu255SZ5iCNkVHezNGDH5qv6CanJpgBPqYgJU13NNKJhRs1"},
+		{BitcoinCash, "regtest", P2PKH, CashAddrFormat, "m/44'/1'/0'/0/0", "bchreg:qqaz6s295ncfs53m86qj0uw6sl8u2kuw0ypvash69n", "cV6NTLu255SZ5iCNkVHezNGDH5qv6CanJpgBPqYgJU13NNKJhRs1"},
+		{Litecoin, "mainnet", P2PKH, "", "m/44'/2'/0'/0/0", "LUWPbpM43E2p7ZSh8cyTBEkvpHmr3cB8Ez", "T5b4RiWRs7XG8xZ2bCHBoJcn4JrpMTbGRFYXgoZHd7nD8izwqhMK"},
+		{Litecoin, "mainnet", P2SHP2WPKH, "", "m/49'/2'/0'/0/0", "M7wtsL7wSHDBJVMWWhtQfTMSYYkyooAAXM", "T8xSEcthDYN4rNUu4eTqtZTDSvphsjgBNbKawBeCkUqZLZ9MH8Ff"},
+		{Litecoin, "mainnet", P2WPKH, "", "m/84'/2'/0'/0/0", "ltc1qjmxnz78nmc8nq77wuxh25n2es7rzm5c2rkk4wh", "T5ZCYhLqXu6EJKk2nhjvwsaLH357CisixhLGWpKXEiqWTUtzte6o"},
+		{Litecoin, "mainnet", P2TR, "", "m/86'/2'/0'/0/0", "ltc1puht8rk95c53q3u9w3pf9h3jfcutcrl9lxc7rqsdthjrse4k6sn7q9tuqm9", "T7KmxWVahHxywhJbGrM18FtVchXFMTBUsmtV2eftqgjbGy3d6JS9"},
+		{Litecoin, "testnet", P2PKH, "", "m/44'/1'/0'/0/0", "mkpZhYtJu2r87Js3pDiWJDmPte2NRZ8bJV", "cV6NTLu255SZ5iCNkVHezNGDH5qv6CanJpgBPqYgJU13NNKJhRs1"},
+		{Litecoin, "testnet", P2SHP2WPKH, "", "m/49'/1'/0'/0/0", "QRHtkDQdVvNNwrVjEdeCGviCw7Ny3SNNiA", "cULrpoZGXiuC19Uhvykx7NugygA3k86b3hmdCeyvHYQZSxojGyXJ"},
+		{Litecoin, "testnet", P2WPKH, "", "m/84'/1'/0'/0/0", "tltc1q6rz28mcfaxtmd6v789l9rrlrusdprr9pesrjxk", "cTGhosGriPpuGA586jemcuH9pE9spwUmneMBmYYzrQEbY92DJrbo"},
+		{Dogecoin, "mainnet", P2PKH, "", "m/44'/3'/0'/0/0", "DBus3bamQjgJULBJtYXpEzDWQRwF5iwxgC", "QPkeC1ZfHx3c9g7WTj9cQ8gnvk2iSAfAcbq1aVAWjNTwDAKfZUzx"},
+		{Dogecoin, "testnet", P2PKH, "", "m/44'/1'/0'/0/0", "nZVmfmUtKPmskB9Ds4P9GUJy9eYFqPKHqH", "cnFdbfcpRPGq2kDure5SaiTBmxFDm38EdhvSWkEsHRWTw2h4kW7W"},
+		{Dash, "mainnet", P2PKH, "", "m/44'/5'/0'/0/0", "XoJA8qE3N2Y3jMLEtZ3vcN42qseZ8LvFf5", "XGihgbi7c1nVqrjkPSvzJydLVWYW7hTrcXdfSdpFMwi3Xhbabw93"},
+		{Dash, "testnet", P2PKH, "", "m/44'/1'/0'/0/0", "yRd4FhXfVGHXpsuZXPNkMrfD9GVj46pnjt", "cV6NTLu255SZ5iCNkVHezNGDH5qv6CanJpgBPqYgJU13NNKJhRs1"},
+		{Zcash, "mainnet", P2PKH, "", "m/44'/133'/0'/0/0", "t1XVXWCvpMgBvUaed4XDqWtgQgJSu1Ghz7F", "KzEVy5oaBPhVPxH43bUg1g1zTv5Jitvc9nZesqqqc18Edi4bHQbu"},
+		{Zcash, "testnet", P2PKH, "", "m/44'/1'/0'/0/0", "tmF1xjfhsSzhy55dmhorzTnKjtHhZmPKzts", "cV6NTLu255SZ5iCNkVHezNGDH5qv6CanJpgBPqYgJU13NNKJhRs1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.chain.Name()+" "+tt.network+" "+string(tt.addressType)+" "+string(tt.format), func(t *testing.T) {
+			chain, err := tt.chain.WithNetwork(tt.network)
+			if err == nil {
+				chain, err = chain.WithAddressType(tt.addressType)
+			}
+
+			if err == nil && tt.format != "" {
+				chain, err = chain.WithAddressFormat(tt.format)
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			addr := deriveTestAddress(t, chain, testMnemonic, 0)
+
+			if addr.DerivationPath != tt.path {
+				t.Errorf("got path %s, want %s", addr.DerivationPath, tt.path)
+			}
+
+			if addr.Address != tt.address {
+				t.Errorf("got address %s, want %s", addr.Address, tt.address)
+			}
+
+			if addr.PrivKeyEncoded != tt.wif {
+				t.Errorf("got WIF %s, want %s", addr.PrivKeyEncoded, tt.wif)
+			}
+		})
+	}
+}
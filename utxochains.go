@@ -0,0 +1,151 @@
+package bip39gen
+
+// Bitcoin-like chains, generating addresses for their first network
+// using their default address type.
+// Use UTXOChain.WithNetwork and UTXOChain.WithAddressType
+// to generate other networks' or types of addresses.
+//
+// Adding a chain only requires adding its networks' parameters
+// here and registering it in chains.
+var (
+	Bitcoin = newUTXOChain(
+		"bitcoin", P2WPKH,
+		UTXONetwork{
+			Name:             "mainnet",
+			CoinType:         0,
+			PubKeyHashPrefix: []byte{0x00},
+			ScriptHashPrefix: []byte{0x05},
+			WIFPrefix:        0x80,
+			Bech32HRP:        "bc",
+		},
+		UTXONetwork{
+			Name:             "testnet",
+			CoinType:         1,
+			PubKeyHashPrefix: []byte{0x6f},
+			ScriptHashPrefix: []byte{0xc4},
+			WIFPrefix:        0xef,
+			Bech32HRP:        "tb",
+		},
+		UTXONetwork{
+			Name:             "signet",
+			CoinType:         1,
+			PubKeyHashPrefix: []byte{0x6f},
+			ScriptHashPrefix: []byte{0xc4},
+			WIFPrefix:        0xef,
+			Bech32HRP:        "tb",
+		},
+		UTXONetwork{
+			Name:             "regtest",
+			CoinType:         1,
+			PubKeyHashPrefix: []byte{0x6f},
+			ScriptHashPrefix: []byte{0xc4},
+			WIFPrefix:        0xef,
+			Bech32HRP:        "bcrt",
+		},
+	)
+
+	Litecoin = newUTXOChain(
+		"litecoin", P2WPKH,
+		UTXONetwork{
+			Name:             "mainnet",
+			CoinType:         2,
+			PubKeyHashPrefix: []byte{0x30},
+			ScriptHashPrefix: []byte{0x32},
+			WIFPrefix:        0xb0,
+			Bech32HRP:        "ltc",
+		},
+		UTXONetwork{
+			Name:             "testnet",
+			CoinType:         1,
+			PubKeyHashPrefix: []byte{0x6f},
+			ScriptHashPrefix: []byte{0x3a},
+			WIFPrefix:        0xef,
+			Bech32HRP:        "tltc",
+		},
+	)
+
+	// BitcoinCash generates CashAddr addresses by default.
+	// Use UTXOChain.WithAddressFormat to generate legacy addresses.
+	BitcoinCash = newUTXOChain(
+		"bitcoin-cash", P2PKH,
+		UTXONetwork{
+			Name:             "mainnet",
+			CoinType:         145,
+			PubKeyHashPrefix: []byte{0x00},
+			ScriptHashPrefix: []byte{0x05},
+			WIFPrefix:        0x80,
+			CashAddrPrefix:   "bitcoincash",
+		},
+		UTXONetwork{
+			Name:             "testnet",
+			CoinType:         1,
+			PubKeyHashPrefix: []byte{0x6f},
+			ScriptHashPrefix: []byte{0xc4},
+			WIFPrefix:        0xef,
+			CashAddrPrefix:   "bchtest",
+		},
+		UTXONetwork{
+			Name:             "regtest",
+			CoinType:         1,
+			PubKeyHashPrefix: []byte{0x6f},
+			ScriptHashPrefix: []byte{0xc4},
+			WIFPrefix:        0xef,
+			CashAddrPrefix:   "bchreg",
+		},
+	)
+
+	Dogecoin = newUTXOChain(
+		"dogecoin", P2PKH,
+		UTXONetwork{
+			Name:             "mainnet",
+			CoinType:         3,
+			PubKeyHashPrefix: []byte{0x1e},
+			ScriptHashPrefix: []byte{0x16},
+			WIFPrefix:        0x9e,
+		},
+		UTXONetwork{
+			Name:             "testnet",
+			CoinType:         1,
+			PubKeyHashPrefix: []byte{0x71},
+			ScriptHashPrefix: []byte{0xc4},
+			WIFPrefix:        0xf1,
+		},
+	)
+
+	Dash = newUTXOChain(
+		"dash", P2PKH,
+		UTXONetwork{
+			Name:             "mainnet",
+			CoinType:         5,
+			PubKeyHashPrefix: []byte{0x4c},
+			ScriptHashPrefix: []byte{0x10},
+			WIFPrefix:        0xcc,
+		},
+		UTXONetwork{
+			Name:             "testnet",
+			CoinType:         1,
+			PubKeyHashPrefix: []byte{0x8c},
+			ScriptHashPrefix: []byte{0x13},
+			WIFPrefix:        0xef,
+		},
+	)
+
+	// Zcash only generates transparent addresses.
+	Zcash = newUTXOChain(
+		"zcash", P2PKH,
+		UTXONetwork{
+			Name:             "mainnet",
+			CoinType:         133,
+			PubKeyHashPrefix: []byte{0x1c, 0xb8},
+			ScriptHashPrefix: []byte{0x1c, 0xbd},
+			WIFPrefix:        0x80,
+		},
+		UTXONetwork{
+			Name:             "testnet",
+			CoinType:         1,
+			PubKeyHashPrefix: []byte{0x1d, 0x25},
+			ScriptHashPrefix: []byte{0x1c, 0xba},
+			WIFPrefix:        0xef,
+		},
+	)
+)
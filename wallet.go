@@ -0,0 +1,137 @@
+package bip39gen
+
+import (
+	"github.com/btcsuite/btcd/btcutil/hdkeychain"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jalavosus/hdwallet-go"
+	"github.com/pkg/errors"
+)
+
+// hdNode holds the BIP39 data of a single mnemonic along with the extended key
+// at the part of a PathTemplate which doesn't depend on the wallet index,
+// so that deriving any number of addresses from it only requires running
+// the seed stretch and master key derivation once.
+type hdNode struct {
+	chain      Chain
+	wallet     *hdwallet.HDWallet
+	template   PathTemplate
+	pathPreset string
+	account    uint32
+	hardened   bool
+	prefixLen  int
+	prefixKey  hdKey
+}
+
+func newHDNode(chain Chain, template PathTemplate, account uint32, hardened bool, passphrase string, params ...hdwallet.NewWalletOpt) (*hdNode, error) {
+	if !template.isZero() && template.indexPosition() == len(template.components) {
+		return nil, wrapErr(ErrInvalidPathTemplate, errors.New("template has no index component"))
+	}
+
+	if _, ok := chain.(unhardenedEngine); ok && template.hasHardened(hardened) {
+		return nil, wrapErr(ErrInvalidPathTemplate, errors.Errorf("%s paths can't have hardened components", chain.Name()))
+	}
+
+	if account != 0 && !template.hasAccount() {
+		return nil, wrapErr(ErrInvalidPathTemplate, errors.Errorf("account %d was set, but the path template has no %s component", account, AccountPlaceholder))
+	}
+
+	if account >= hdkeychain.HardenedKeyStart {
+		return nil, wrapErr(ErrDerivation, errors.Errorf("account %d must be less than %d", account, hdkeychain.HardenedKeyStart))
+	}
+
+	wallet, err := hdwallet.NewHDWallet(params...)
+	if err != nil {
+		return nil, wrapErr(ErrDerivation, err)
+	}
+
+	prefixKey, err := chain.newMasterKey(keySource{
+		seed:       wallet.Seed(),
+		entropy:    wallet.Entropy(),
+		passphrase: passphrase,
+	})
+	if err != nil {
+		return nil, wrapErr(ErrDerivation, err)
+	}
+
+	prefixLen := template.indexPosition()
+
+	for _, n := range template.Resolve(account, 0, hardened)[:prefixLen] {
+		prefixKey, err = prefixKey.derive(n)
+		if err != nil {
+			return nil, wrapErr(ErrDerivation, err)
+		}
+	}
+
+	// hdkeychain computes and caches an extended private key's public key
+	// the first time a non-hardened child is derived from it, so do that now
+	// to allow derive to be called concurrently.
+	if k, ok := prefixKey.(bip32Key); ok {
+		if _, err = k.ECPubKey(); err != nil {
+			return nil, wrapErr(ErrDerivation, err)
+		}
+	}
+
+	return &hdNode{
+		chain:     chain,
+		wallet:    wallet,
+		template:  template,
+		account:   account,
+		hardened:  hardened,
+		prefixLen: prefixLen,
+		prefixKey: prefixKey,
+	}, nil
+}
+
+// derive derives the address at wallet index idx of the node's path template.
+func (n *hdNode) derive(idx int) (AddressData, error) {
+	if n.template.isZero() {
+		return n.deriveMaster()
+	}
+
+	var (
+		path     = n.template.Resolve(n.account, uint32(idx), n.hardened)
+		childKey = n.prefixKey
+		err      error
+	)
+
+	for _, c := range path[n.prefixLen:] {
+		childKey, err = childKey.derive(c)
+		if err != nil {
+			return AddressData{}, wrapErr(ErrDerivation, err)
+		}
+	}
+
+	ad := AddressData{
+		Chain:          n.chain.Name(),
+		Entropy:        common.Bytes2Hex(n.wallet.Entropy()),
+		Seed:           common.Bytes2Hex(n.wallet.Seed()),
+		Mnemonic:       n.wallet.Mnemonic(),
+		WalletIndex:    idx,
+		DerivationPath: path.String(),
+		PathPreset:     n.pathPreset,
+		Hardened:       n.template.IndexHardened(n.hardened),
+	}
+
+	if err = n.chain.fillAddressData(childKey, &ad); err != nil {
+		return AddressData{}, err
+	}
+
+	return ad, nil
+}
+
+// deriveMaster returns the address of the node's master key,
+// for chains whose keys aren't derived from paths.
+func (n *hdNode) deriveMaster() (AddressData, error) {
+	ad := AddressData{
+		Chain:    n.chain.Name(),
+		Entropy:  common.Bytes2Hex(n.wallet.Entropy()),
+		Seed:     common.Bytes2Hex(n.wallet.Seed()),
+		Mnemonic: n.wallet.Mnemonic(),
+	}
+
+	if err := n.chain.fillAddressData(n.prefixKey, &ad); err != nil {
+		return AddressData{}, err
+	}
+
+	return ad, nil
+}
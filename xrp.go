@@ -0,0 +1,138 @@
+package bip39gen
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+const (
+	bitcoinAlphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	rippleAlphabet  = "rpshnaf39wBUDNEGHJKLM4PQRST7VWXYZ2bcdeCg65jkm8oFqi1tuvAxyz"
+)
+
+// toRippleAlphabet translates base58 strings from the bitcoin alphabet
+// to the XRP Ledger's.
+var toRippleAlphabet = func() *strings.Replacer {
+	pairs := make([]string, 0, 2*len(bitcoinAlphabet))
+
+	for i := range bitcoinAlphabet {
+		pairs = append(pairs, bitcoinAlphabet[i:i+1], rippleAlphabet[i:i+1])
+	}
+
+	return strings.NewReplacer(pairs...)
+}()
+
+var (
+	xrpAccountIDPrefix = []byte{0x00}
+	xrpXAddressPrefix  = []byte{0x05, 0x44}
+)
+
+// X-address flag bytes, indicating whether a destination tag is present.
+const (
+	xrpNoDestinationTag   byte = 0x00
+	xrpWithDestinationTag byte = 0x01
+)
+
+// XRP is the Chain for the XRP Ledger, generating classic addresses.
+// Use XRPChain.WithAddressFormat or XRPChain.WithDestinationTag
+// to generate X-addresses.
+var XRP = XRPChain{AddressFormat: ClassicFormat}
+
+// XRPChain is a Chain for the XRP Ledger, whose addresses encode
+// RIPEMD160(SHA256(compressed pubkey)) using the XRP Ledger's
+// base58 alphabet.
+//
+// PrivKeyEncoded isn't set, as XRP Ledger secrets (s...) encode the
+// entropy keys are generated from rather than a private key.
+type XRPChain struct {
+	// AddressFormat is ClassicFormat or XAddressFormat.
+	AddressFormat AddressFormat
+	// DestinationTag is encoded into X-addresses if it isn't nil.
+	DestinationTag *uint32
+
+	bip32Engine
+}
+
+func (c XRPChain) Name() string {
+	return "xrp"
+}
+
+// DefaultPathTemplate returns m/44'/144'/{account}'/0/{index}.
+func (c XRPChain) DefaultPathTemplate() PathTemplate {
+	return MustParsePathTemplate(fmt.Sprintf("m/44'/144'/%s'/0/%s", AccountPlaceholder, IndexPlaceholder))
+}
+
+// WithAddressFormat returns a copy of the chain which generates
+// addresses in the given format.
+func (c XRPChain) WithAddressFormat(format AddressFormat) (XRPChain, error) {
+	if format != ClassicFormat && format != XAddressFormat {
+		return XRPChain{}, wrapErr(ErrUnsupportedChain, errors.Errorf(
+			"xrp does not support %q address format", format,
+		))
+	}
+
+	c.AddressFormat = format
+
+	return c, nil
+}
+
+// WithDestinationTag returns a copy of the chain which generates
+// X-addresses including the given destination tag.
+func (c XRPChain) WithDestinationTag(tag uint32) XRPChain {
+	c.AddressFormat = XAddressFormat
+	c.DestinationTag = &tag
+
+	return c
+}
+
+func (c XRPChain) fillAddressData(key hdKey, ad *AddressData) error {
+	privKey, err := bip32PrivKey(key)
+	if err != nil {
+		return err
+	}
+
+	var (
+		pubKey    = privKey.PubKey().SerializeCompressed()
+		accountID = btcutil.Hash160(pubKey)
+	)
+
+	switch c.AddressFormat {
+	case XAddressFormat:
+		ad.Address = encodeXAddress(accountID, c.DestinationTag)
+	default:
+		ad.Address = rippleBase58CheckEncode(xrpAccountIDPrefix, accountID)
+	}
+
+	ad.AddressFormat = string(c.AddressFormat)
+	ad.PubKey = common.Bytes2Hex(pubKey)
+	ad.PrivKey = common.Bytes2Hex(privKey.Serialize())
+
+	return nil
+}
+
+// encodeXAddress encodes a mainnet X-address as specified by XLS-5d.
+func encodeXAddress(accountID []byte, tag *uint32) string {
+	// the flag byte is followed by a 64-bit little-endian tag,
+	// whose upper 32 bits are reserved and must be zero
+	payload := make([]byte, len(accountID)+1+8)
+	copy(payload, accountID)
+
+	if tag != nil {
+		payload[len(accountID)] = xrpWithDestinationTag
+		binary.LittleEndian.PutUint32(payload[len(accountID)+1:], *tag)
+	} else {
+		payload[len(accountID)] = xrpNoDestinationTag
+	}
+
+	return rippleBase58CheckEncode(xrpXAddressPrefix, payload)
+}
+
+// rippleBase58CheckEncode is base58CheckEncode using the XRP Ledger's alphabet.
+func rippleBase58CheckEncode(version, payload []byte) string {
+	return toRippleAlphabet.Replace(base58CheckEncode(version, payload))
+}
@@ -0,0 +1,65 @@
+package bip39gen
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil/base58"
+)
+
+// decodeClassicAddress returns the account ID encoded in a classic XRP Ledger address.
+func decodeClassicAddress(t *testing.T, address string) []byte {
+	t.Helper()
+
+	pairs := make([]string, 0, 2*len(rippleAlphabet))
+
+	for i := range rippleAlphabet {
+		pairs = append(pairs, rippleAlphabet[i:i+1], bitcoinAlphabet[i:i+1])
+	}
+
+	accountID, version, err := base58.CheckDecode(strings.NewReplacer(pairs...).Replace(address))
+	if err != nil || version != xrpAccountIDPrefix[0] {
+		t.Fatalf("invalid classic address %s: version %d, %v", address, version, err)
+	}
+
+	return accountID
+}
+
+func TestEncodeXAddress(t *testing.T) {
+	// XLS-5d test vectors
+	tag := func(tag uint32) *uint32 { return &tag }
+
+	tests := []struct {
+		classic  string
+		tag      *uint32
+		xAddress string
+	}{
+		{"r9cZA1mLK5R5Am25ArfXFmqgNwjZgnfk59", nil, "X7AcgcsBL6XDcUb289X4mJ8djcdyKaB5hJDWMArnXr61cqZ"},
+		{"r9cZA1mLK5R5Am25ArfXFmqgNwjZgnfk59", tag(1), "X7AcgcsBL6XDcUb289X4mJ8djcdyKaGZMhc9YTE92ehJ2Fu"},
+		{"rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf", nil, "XVLhHMPHU98es4dbozjVtdWzVrDjtV5fdx1mHp98tDMoQXb"},
+		{"rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf", tag(1), "XVLhHMPHU98es4dbozjVtdWzVrDjtV8xvjGQTYPiAx6gwDC"},
+		{"rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf", tag(4294967295), "XVLhHMPHU98es4dbozjVtdWzVrDjtV18pX8yuPT7y4xaEHi"},
+	}
+
+	for _, tt := range tests {
+		if got := encodeXAddress(decodeClassicAddress(t, tt.classic), tt.tag); got != tt.xAddress {
+			t.Errorf("%s: got %s, want %s", tt.classic, got, tt.xAddress)
+		}
+	}
+}
+
+func TestXRP(t *testing.T) {
+	const classic = "rHsMGQEkVNJmpGWs8XUBoTBiAAbwxZN5v3"
+
+	addr := deriveTestAddress(t, XRP, testMnemonic, 0)
+	if addr.Address != classic || addr.DerivationPath != "m/44'/144'/0'/0/0" {
+		t.Errorf("got %s at %s, want %s at m/44'/144'/0'/0/0", addr.Address, addr.DerivationPath, classic)
+	}
+
+	tag := uint32(1)
+
+	addr = deriveTestAddress(t, XRP.WithDestinationTag(tag), testMnemonic, 0)
+	if want := encodeXAddress(decodeClassicAddress(t, classic), &tag); addr.Address != want {
+		t.Errorf("got X-address %s, want %s", addr.Address, want)
+	}
+}